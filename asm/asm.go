@@ -0,0 +1,605 @@
+// Package asm is a 16-bit assembler for the subset of NASM syntax used by
+// the listings and emitted by the disassembler. It produces the same bytes
+// as NASM does, so tests don't need an external assembler.
+package asm
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	cpu "cpu8086"
+)
+
+// maxPasses limits the number of passes needed to resolve forward references
+const maxPasses = 16
+
+// Assemble translates the source into machine code
+func Assemble(src string) ([]byte, error) {
+	stmts, err := parse(src)
+	if err != nil {
+		return nil, err
+	}
+
+	a := assembler{labels: map[string]int{}}
+
+	for range maxPasses {
+		out, changed, err := a.pass(stmts)
+		if !changed {
+			return out, err
+		}
+	}
+
+	return nil, errors.New("labels don't converge")
+}
+
+type statement struct {
+	line  int
+	label string
+	// times is the repeat count expression of the "times" prefix
+	times string
+	op    string
+	args  []string
+}
+
+type assembler struct {
+	labels  map[string]int
+	defined map[string]bool
+	org     int
+	addr    int
+	out     []byte
+}
+
+func (a *assembler) here() int  { return a.addr }
+func (a *assembler) start() int { return a.org }
+func (a *assembler) label(name string) (int, bool) {
+	v, ok := a.labels[name]
+	return v, ok
+}
+
+// pass assembles all statements once. It reports whether any label has
+// moved, in which case the output is not final yet and errors are ignored:
+// they could be caused by labels which are not known yet
+func (a *assembler) pass(stmts []statement) (out []byte, changed bool, err error) {
+	a.defined = map[string]bool{}
+	a.org = 0
+	a.addr = 0
+	a.out = nil
+
+	for _, stmt := range stmts {
+		stmtErr := a.process(stmt, &changed)
+		if stmtErr != nil && err == nil {
+			err = fmt.Errorf("line %d: %w", stmt.line, stmtErr)
+		}
+	}
+
+	if changed {
+		return a.out, true, nil
+	}
+	return a.out, false, err
+}
+
+func (a *assembler) process(stmt statement, changed *bool) error {
+	if stmt.label != "" {
+		if a.defined[stmt.label] {
+			return fmt.Errorf("label redefined: %s", stmt.label)
+		}
+		a.defined[stmt.label] = true
+
+		if v, ok := a.labels[stmt.label]; !ok || v != a.addr {
+			a.labels[stmt.label] = a.addr
+			*changed = true
+		}
+	}
+
+	if stmt.op == "" {
+		return nil
+	}
+
+	count := 1
+	if stmt.times != "" {
+		var err error
+		count, err = evalExpr(stmt.times, a)
+		if err != nil {
+			return err
+		}
+		if count < 0 {
+			return fmt.Errorf("negative repeat count: %d", count)
+		}
+	}
+
+	for range count {
+		if err := a.statement(stmt); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (a *assembler) emit(b ...byte) {
+	a.out = append(a.out, b...)
+	a.addr += len(b)
+}
+
+func (a *assembler) statement(stmt statement) error {
+	switch stmt.op {
+	case "bits":
+		if len(stmt.args) != 1 || strings.TrimSpace(stmt.args[0]) != "16" {
+			return errors.New("only \"bits 16\" is supported")
+		}
+		return nil
+	case "org":
+		if len(stmt.args) != 1 {
+			return errors.New("org expects one argument")
+		}
+		if len(a.out) > 0 {
+			return errors.New("org after code")
+		}
+		org, err := evalExpr(stmt.args[0], a)
+		if err != nil {
+			return err
+		}
+		a.org = org
+		a.addr = org
+		return nil
+	case "db":
+		return a.data(stmt.args, 1)
+	case "dw":
+		return a.data(stmt.args, 2)
+	default:
+		return a.instruction(stmt)
+	}
+}
+
+func (a *assembler) data(args []string, size int) error {
+	for _, arg := range args {
+		arg = strings.TrimSpace(arg)
+
+		if arg != "" && strings.IndexByte("'\"`", arg[0]) != -1 {
+			str, n, err := parseString(arg)
+			if err != nil {
+				return err
+			}
+			if n == len(arg) {
+				a.emit([]byte(str)...)
+				// NOTE: strings are padded up to the size of the data unit
+				for len(str)%size != 0 {
+					a.emit(0)
+					str += "\x00"
+				}
+				continue
+			}
+		}
+
+		v, err := evalExpr(arg, a)
+		if err != nil {
+			return err
+		}
+
+		if !fits(v, size) {
+			return fmt.Errorf("data is out of range: %d", v)
+		}
+		if size == 1 {
+			a.emit(byte(v))
+		} else {
+			a.emit(byte(v), byte(v>>8))
+		}
+	}
+	return nil
+}
+
+// NOTE: NASM accepts a few aliases for the same encodings
+var mnemonicAliases = map[string]cpu.Mnemonic{
+	"jz":     cpu.JE,
+	"jnge":   cpu.JL,
+	"jng":    cpu.JLE,
+	"jnae":   cpu.JB,
+	"jc":     cpu.JB,
+	"jna":    cpu.JBE,
+	"jpe":    cpu.JP,
+	"jge":    cpu.JNL,
+	"jnle":   cpu.JG,
+	"jae":    cpu.JNB,
+	"jnc":    cpu.JNB,
+	"jnbe":   cpu.JA,
+	"jpo":    cpu.JNP,
+	"loope":  cpu.LOOPZ,
+	"loopne": cpu.LOOPNZ,
+}
+
+func (a *assembler) instruction(stmt statement) error {
+	mnemonic, ok := cpu.ParseMnemonic(stmt.op)
+	if !ok {
+		mnemonic, ok = mnemonicAliases[stmt.op]
+	}
+	if !ok {
+		return fmt.Errorf("unknown instruction: %s", stmt.op)
+	}
+
+	var inst cpu.Instruction
+
+	switch n := len(stmt.args); {
+	case n == 0:
+		inst = cpu.NewInstruction(mnemonic, cpu.Operand{}, cpu.Operand{})
+	case n == 1 && mnemonic == cpu.INT:
+		typ, err := evalExpr(stmt.args[0], a)
+		if err == nil && (typ < 0 || typ > 0xFF) {
+			err = fmt.Errorf("interrupt type is out of range: %d", typ)
+		}
+		if err != nil {
+			return err
+		}
+		inst = cpu.NewInstruction(mnemonic, cpu.OperandImm(int16(typ), false), cpu.Operand{})
+	case n == 1 && mnemonic.IsJump():
+		const jmpInstSize = 2
+
+		target, err := evalExpr(strings.TrimPrefix(stmt.args[0], "short "), a)
+		rel := target - (a.addr + jmpInstSize)
+		if err == nil && (rel < -128 || rel > 127) {
+			err = fmt.Errorf("short jump is out of range: %d", rel)
+		}
+		if err != nil {
+			// NOTE: keep the following labels in place, so the next pass sees right addresses
+			a.emit(make([]byte, jmpInstSize)...)
+			return err
+		}
+		inst = cpu.NewJump(mnemonic, int8(rel))
+	case n == 2 && (mnemonic == cpu.IN || mnemonic == cpu.OUT):
+		dst, err := a.operand(stmt.args[0])
+		if err != nil {
+			return err
+		}
+		src, err := a.operand(stmt.args[1])
+		if err != nil {
+			return err
+		}
+
+		// NOTE: the port is a byte, whatever the size of the accumulator is
+		dstOp, err := portOperand(dst)
+		if err != nil {
+			return err
+		}
+		srcOp, err := portOperand(src)
+		if err != nil {
+			return err
+		}
+		inst = cpu.NewInstruction(mnemonic, dstOp, srcOp)
+	case n == 2:
+		dst, err := a.operand(stmt.args[0])
+		if err != nil {
+			return err
+		}
+		src, err := a.operand(stmt.args[1])
+		if err != nil {
+			return err
+		}
+
+		dstOp, srcOp, err := buildOperands(dst, src)
+		if err != nil {
+			return err
+		}
+		inst = cpu.NewInstruction(mnemonic, dstOp, srcOp)
+	default:
+		return fmt.Errorf("invalid number of operands: %d", len(stmt.args))
+	}
+
+	code, err := cpu.Encode(inst)
+	if err != nil {
+		return err
+	}
+	a.emit(code...)
+
+	return nil
+}
+
+type argKind int
+
+const (
+	argReg argKind = iota + 1
+	argMem
+	argImm
+)
+
+type arg struct {
+	kind argKind
+	// size is 1 for "byte" and 2 for "word". 0 means not specified
+	size  int
+	reg   cpu.Register
+	regs  []cpu.Register
+	value int
+}
+
+type register struct {
+	reg  cpu.Register
+	word bool
+}
+
+var registers = map[string]register{
+	"al": {cpu.AL, false},
+	"cl": {cpu.CL, false},
+	"dl": {cpu.DL, false},
+	"bl": {cpu.BL, false},
+	"ah": {cpu.AH, false},
+	"ch": {cpu.CH, false},
+	"dh": {cpu.DH, false},
+	"bh": {cpu.BH, false},
+	"ax": {cpu.AX, true},
+	"cx": {cpu.CX, true},
+	"dx": {cpu.DX, true},
+	"bx": {cpu.BX, true},
+	"sp": {cpu.SP, true},
+	"bp": {cpu.BP, true},
+	"si": {cpu.SI, true},
+	"di": {cpu.DI, true},
+}
+
+func (a *assembler) operand(s string) (out arg, err error) {
+	s = strings.TrimSpace(s)
+
+	keyword, rest, _ := strings.Cut(s, " ")
+	switch strings.ToLower(keyword) {
+	case "byte":
+		out.size = 1
+		s = strings.TrimSpace(rest)
+	case "word":
+		out.size = 2
+		s = strings.TrimSpace(rest)
+	}
+
+	if r, ok := registers[strings.ToLower(s)]; ok {
+		out.kind = argReg
+		out.reg = r.reg
+		if r.word {
+			out.size = 2
+		} else {
+			out.size = 1
+		}
+		return
+	}
+
+	if strings.HasPrefix(s, "[") {
+		if !strings.HasSuffix(s, "]") {
+			err = fmt.Errorf("missing ']': %s", s)
+			return
+		}
+		out.kind = argMem
+		out.regs, out.value, err = a.address(s[1 : len(s)-1])
+		return
+	}
+
+	out.kind = argImm
+	out.value, err = evalExpr(s, a)
+	return
+}
+
+// address splits an effective address into registers and a displacement
+func (a *assembler) address(s string) (regs []cpu.Register, disp int, err error) {
+	var (
+		expr  strings.Builder
+		depth int
+		start int
+	)
+
+	terms := make([]string, 0, 4)
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case '+', '-':
+			if depth == 0 && i > start {
+				terms = append(terms, s[start:i])
+				start = i
+			}
+		}
+	}
+	terms = append(terms, s[start:])
+
+	for _, term := range terms {
+		name := strings.ToLower(strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(term), "+")))
+		if r, ok := registers[name]; ok {
+			if strings.HasPrefix(strings.TrimSpace(term), "-") {
+				err = fmt.Errorf("register can't be subtracted: %s", name)
+				return
+			}
+			if !r.word {
+				err = fmt.Errorf("invalid register in address: %s", name)
+				return
+			}
+			regs = append(regs, r.reg)
+			continue
+		}
+		expr.WriteString(term)
+	}
+
+	if strings.TrimSpace(expr.String()) != "" {
+		disp, err = evalExpr(expr.String(), a)
+		if err != nil {
+			return
+		}
+	}
+
+	// NOTE: the base register goes first, like in the EACTable
+	if len(regs) == 2 && (regs[0] == cpu.SI || regs[0] == cpu.DI) {
+		regs[0], regs[1] = regs[1], regs[0]
+	}
+
+	return
+}
+
+func buildOperands(dst, src arg) (dstOp, srcOp cpu.Operand, err error) {
+	size := max(dst.size, src.size)
+	if dst.kind == argReg && src.kind == argReg && dst.size != src.size {
+		err = errors.New("mismatch in operand sizes")
+		return
+	}
+	if dst.kind == argImm {
+		err = errors.New("invalid destination operand")
+		return
+	}
+	if dst.kind == argMem && src.kind == argImm && size == 0 {
+		err = errors.New("operation size not specified")
+		return
+	}
+	// NOTE: like NASM, values fit if they are either signed or unsigned
+	if src.kind == argImm && !fits(src.value, size) {
+		err = fmt.Errorf("immediate is out of range: %d", src.value)
+		return
+	}
+	for _, o := range [...]arg{dst, src} {
+		if o.kind == argMem && !fits(o.value, 2) {
+			err = fmt.Errorf("displacement is out of range: %d", o.value)
+			return
+		}
+	}
+
+	dstOp = buildOperand(dst, size)
+	srcOp = buildOperand(src, size)
+	return
+}
+
+// fits reports whether the value fits the size in bytes as a signed or an
+// unsigned number
+func fits(v, size int) bool {
+	bits := 8 * size
+	return v >= -1<<(bits-1) && v < 1<<bits
+}
+
+// portOperand builds an operand of IN and OUT: the accumulator, DX or a byte port
+func portOperand(o arg) (cpu.Operand, error) {
+	switch o.kind {
+	case argReg:
+		return cpu.OperandReg(o.reg), nil
+	case argImm:
+		if o.value < 0 || o.value > 0xFF {
+			return cpu.Operand{}, fmt.Errorf("port is out of range: %d", o.value)
+		}
+		return cpu.OperandImm(int16(o.value), false), nil
+	default:
+		return cpu.Operand{}, errors.New("invalid operand of a port instruction")
+	}
+}
+
+func buildOperand(o arg, size int) cpu.Operand {
+	switch o.kind {
+	case argReg:
+		return cpu.OperandReg(o.reg)
+	case argMem:
+		return cpu.OperandMem(int16(o.value), o.regs...)
+	default:
+		return cpu.OperandImm(int16(o.value), size == 2)
+	}
+}
+
+// parse splits the source into statements
+func parse(src string) ([]statement, error) {
+	var stmts []statement
+
+	lineNum := 0
+	for line := range strings.Lines(src) {
+		lineNum++
+
+		line = strings.TrimSpace(stripComment(line))
+		if line == "" {
+			continue
+		}
+
+		stmt := statement{line: lineNum}
+
+		// NOTE: "[bits 16]" is the primitive form of the directive
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			line = strings.TrimSpace(line[1 : len(line)-1])
+		}
+
+		if n := identLen(line); n > 0 && strings.HasPrefix(line[n:], ":") {
+			stmt.label = line[:n]
+			line = strings.TrimSpace(line[n+1:])
+		}
+
+		op, rest := cutWord(line)
+		if strings.ToLower(op) == "times" {
+			_, after, err := parseExpr(rest, nopScope{})
+			if err != nil && !errors.Is(err, errUndefinedLabel) {
+				return nil, fmt.Errorf("line %d: %w", lineNum, err)
+			}
+			// NOTE: the count is evaluated on every pass, only its text is kept here
+			stmt.times = strings.TrimSpace(rest[:len(rest)-len(after)])
+			op, rest = cutWord(after)
+		}
+
+		stmt.op = strings.ToLower(op)
+		if rest != "" {
+			stmt.args = splitArgs(rest)
+		}
+
+		stmts = append(stmts, stmt)
+	}
+
+	return stmts, nil
+}
+
+// nopScope resolves nothing. It's used to find the end of an expression
+type nopScope struct{}
+
+func (nopScope) here() int                { return 0 }
+func (nopScope) start() int               { return 0 }
+func (nopScope) label(string) (int, bool) { return 0, true }
+
+func cutWord(s string) (word, rest string) {
+	s = strings.TrimSpace(s)
+	i := strings.IndexAny(s, " \t")
+	if i == -1 {
+		return s, ""
+	}
+	return s[:i], strings.TrimSpace(s[i:])
+}
+
+// stripComment removes a comment, ignoring semicolons in strings
+func stripComment(line string) string {
+	var quote byte
+	for i := 0; i < len(line); i++ {
+		c := line[i]
+		switch {
+		case quote != 0 && c == quote:
+			quote = 0
+		case quote != 0:
+		case c == '\'' || c == '"' || c == '`':
+			quote = c
+		case c == ';':
+			return line[:i]
+		}
+	}
+	return line
+}
+
+// splitArgs splits operands by commas, ignoring commas in strings and brackets
+func splitArgs(s string) []string {
+	var (
+		args  []string
+		quote byte
+		depth int
+		start int
+	)
+
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case quote != 0 && c == quote:
+			quote = 0
+		case quote != 0:
+		case c == '\'' || c == '"' || c == '`':
+			quote = c
+		case c == '[' || c == '(':
+			depth++
+		case c == ']' || c == ')':
+			depth--
+		case c == ',' && depth == 0:
+			args = append(args, strings.TrimSpace(s[start:i]))
+			start = i + 1
+		}
+	}
+
+	return append(args, strings.TrimSpace(s[start:]))
+}
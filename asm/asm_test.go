@@ -0,0 +1,180 @@
+package asm
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAssembleListings(t *testing.T) {
+	filenames := []string{
+		"0037_single_register_mov",
+		"0038_many_register_mov",
+		"0039_more_movs",
+		"0040_challenge_movs",
+		"0041_add_sub_cmp_jnz",
+	}
+
+	for _, name := range filenames {
+		t.Run(name, func(t *testing.T) {
+			src, err := os.ReadFile("../listings/" + name + ".asm")
+			require.NoError(t, err)
+
+			want, err := os.ReadFile("../listings/" + name + ".bin")
+			require.NoError(t, err)
+
+			got, err := Assemble(string(src))
+			require.NoError(t, err)
+			require.Equal(t, want, got)
+		})
+	}
+}
+
+func TestAssemble(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		want []byte
+	}{
+		{
+			name: "relative jumps",
+			src:  "bits 16\njnz $+4+0\njnz $-2+0\njnz $+0\n",
+			want: []byte{0x75, 0x02, 0x75, 0xfc, 0x75, 0xfe},
+		},
+		{
+			name: "forward label",
+			src:  "jcxz done ; skip\nadd ax, 1\ndone:\n",
+			want: []byte{0xe3, 0x03, 0x83, 0xc0, 0x01},
+		},
+		{
+			name: "org and labels",
+			src:  "org 0x100\nstart: mov ax, [data]\ndata: dw start, 0FFFFh\n",
+			want: []byte{0xa1, 0x03, 0x01, 0x00, 0x01, 0xff, 0xff},
+		},
+		{
+			name: "db with strings",
+			src:  "db 'hi; there', 0\ndb \"a\", 10, 13\ndw 'abc'\n",
+			want: []byte("hi; there\x00a\n\rabc\x00"),
+		},
+		{
+			name: "times",
+			src:  "mov cl, 1\ntimes 4-($-$$) db 0x90\n",
+			want: []byte{0xb1, 0x01, 0x90, 0x90},
+		},
+		{
+			name: "size keywords",
+			src:  "mov [bx+si], byte 7\nsub word [si + 1 + 1], 1\n",
+			want: []byte{0xc6, 0x00, 0x07, 0x83, 0x6c, 0x02, 0x01},
+		},
+		{
+			name: "no operands",
+			src:  "mov ax, 1\nhlt\n",
+			want: []byte{0xb8, 0x01, 0x00, 0xf4},
+		},
+		{
+			name: "short jmp",
+			src:  "jmp short end\njmp end\nend:\n",
+			want: []byte{0xeb, 0x02, 0xeb, 0x00},
+		},
+		{
+			name: "int and ret",
+			src:  "int 21h\nret\n",
+			want: []byte{0xcd, 0x21, 0xc3},
+		},
+		{
+			name: "in, out and iret",
+			src:  "in al, 60h\nout dx, ax\ncli\nsti\niret\n",
+			want: []byte{0xe4, 0x60, 0xef, 0xfa, 0xfb, 0xcf},
+		},
+		{
+			name: "signed and unsigned limits",
+			src:  "mov al, -128\nmov al, 255\nmov ax, 65535\nmov ax, [bx - 32768]\n",
+			want: []byte{0xb0, 0x80, 0xb0, 0xff, 0xb8, 0xff, 0xff, 0x8b, 0x87, 0x00, 0x80},
+		},
+		{
+			name: "aliases and case",
+			src:  "JZ label\nlabel: CMP AL, 'a'\n",
+			want: []byte{0x74, 0x00, 0x3c, 0x61},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Assemble(tt.src)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestAssembleErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		want string
+	}{
+		{
+			name: "undefined label",
+			src:  "mov ax, bx\njnz nowhere\n",
+			want: "line 2: undefined label: nowhere",
+		},
+		{
+			name: "size not specified",
+			src:  "mov [bx], 1\n",
+			want: "line 1: operation size not specified",
+		},
+		{
+			name: "jump out of range",
+			src:  "jnz far\ntimes 200 db 0\nfar:\n",
+			want: "line 1: short jump is out of range: 200",
+		},
+		{
+			name: "unknown instruction",
+			src:  "bits 16\nnop\n",
+			want: "line 2: unknown instruction: nop",
+		},
+		{
+			name: "interrupt type out of range",
+			src:  "int 256\n",
+			want: "line 1: interrupt type is out of range: 256",
+		},
+		{
+			name: "port out of range",
+			src:  "out 100h, al\n",
+			want: "line 1: port is out of range: 256",
+		},
+		{
+			name: "not a jump",
+			src:  "cmp ax\n",
+			want: "line 1: invalid number of operands: 1",
+		},
+		{
+			name: "byte immediate out of range",
+			src:  "mov al, 256\n",
+			want: "line 1: immediate is out of range: 256",
+		},
+		{
+			name: "word immediate out of range",
+			src:  "add word [bx], -32769\n",
+			want: "line 1: immediate is out of range: -32769",
+		},
+		{
+			name: "displacement out of range",
+			src:  "mov ax, [bx + 10000h]\n",
+			want: "line 1: displacement is out of range: 65536",
+		},
+		{
+			name: "data out of range",
+			src:  "db 1, 300\n",
+			want: "line 1: data is out of range: 300",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Assemble(tt.src)
+			require.EqualError(t, err, tt.want)
+		})
+	}
+}
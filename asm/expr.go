@@ -0,0 +1,259 @@
+package asm
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// scope resolves symbols of an expression
+type scope interface {
+	// here is the address of the current statement ("$")
+	here() int
+	// start is the address of the section start ("$$")
+	start() int
+	// label returns the address of a label. The second value is false when the label
+	// is not defined (yet)
+	label(name string) (int, bool)
+}
+
+// evalExpr evaluates a complete expression
+func evalExpr(s string, sc scope) (int, error) {
+	v, rest, err := parseExpr(s, sc)
+	if err != nil {
+		return 0, err
+	}
+	if strings.TrimSpace(rest) != "" {
+		return 0, fmt.Errorf("unexpected %q after expression", strings.TrimSpace(rest))
+	}
+	return v, nil
+}
+
+// parseExpr evaluates the longest expression at the start of s and returns the rest
+func parseExpr(s string, sc scope) (int, string, error) {
+	p := exprParser{s: s, sc: sc}
+	v, err := p.parseBinary(0)
+	if err != nil {
+		return 0, "", err
+	}
+	return v, p.s[p.pos:], nil
+}
+
+var errUndefinedLabel = errors.New("undefined label")
+
+type exprParser struct {
+	s   string
+	pos int
+	sc  scope
+}
+
+// NOTE: the same precedence as NASM has
+var binaryOps = [...][]string{
+	{"|"},
+	{"^"},
+	{"&"},
+	{"<<", ">>"},
+	{"+", "-"},
+	{"*", "/", "%"},
+}
+
+func (p *exprParser) parseBinary(level int) (int, error) {
+	if level == len(binaryOps) {
+		return p.parseUnary()
+	}
+
+	left, err := p.parseBinary(level + 1)
+	if err != nil {
+		return 0, err
+	}
+
+	for {
+		op := p.peekOp(binaryOps[level])
+		if op == "" {
+			return left, nil
+		}
+		p.pos += len(op)
+
+		right, err := p.parseBinary(level + 1)
+		if err != nil {
+			return 0, err
+		}
+
+		switch op {
+		case "|":
+			left |= right
+		case "^":
+			left ^= right
+		case "&":
+			left &= right
+		case "<<":
+			left <<= right
+		case ">>":
+			left >>= right
+		case "+":
+			left += right
+		case "-":
+			left -= right
+		case "*":
+			left *= right
+		case "/", "%":
+			if right == 0 {
+				return 0, errors.New("division by zero")
+			}
+			if op == "/" {
+				left /= right
+			} else {
+				left %= right
+			}
+		}
+	}
+}
+
+func (p *exprParser) peekOp(ops []string) string {
+	p.skipSpaces()
+	for _, op := range ops {
+		if strings.HasPrefix(p.s[p.pos:], op) {
+			return op
+		}
+	}
+	return ""
+}
+
+func (p *exprParser) parseUnary() (int, error) {
+	p.skipSpaces()
+	if p.pos >= len(p.s) {
+		return 0, errors.New("expression expected")
+	}
+
+	switch p.s[p.pos] {
+	case '-':
+		p.pos++
+		v, err := p.parseUnary()
+		return -v, err
+	case '+':
+		p.pos++
+		return p.parseUnary()
+	case '~':
+		p.pos++
+		v, err := p.parseUnary()
+		return ^v, err
+	case '(':
+		p.pos++
+		v, err := p.parseBinary(0)
+		if err != nil {
+			return 0, err
+		}
+		p.skipSpaces()
+		if p.pos >= len(p.s) || p.s[p.pos] != ')' {
+			return 0, errors.New("missing ')'")
+		}
+		p.pos++
+		return v, nil
+	case '\'', '"', '`':
+		str, n, err := parseString(p.s[p.pos:])
+		if err != nil {
+			return 0, err
+		}
+		p.pos += n
+		// NOTE: character constants are little-endian
+		var v int
+		for i := len(str) - 1; i >= 0; i-- {
+			v = v<<8 | int(str[i])
+		}
+		return v, nil
+	}
+
+	tok := p.s[p.pos:]
+	tok = tok[:identLen(tok)]
+	if tok == "" {
+		return 0, fmt.Errorf("unexpected %q in expression", p.s[p.pos:])
+	}
+	p.pos += len(tok)
+
+	switch {
+	case tok == "$$":
+		return p.sc.start(), nil
+	case tok == "$":
+		return p.sc.here(), nil
+	case isDigit(tok[0]):
+		return parseNumber(tok)
+	default:
+		v, ok := p.sc.label(tok)
+		if !ok {
+			return 0, fmt.Errorf("%w: %s", errUndefinedLabel, tok)
+		}
+		return v, nil
+	}
+}
+
+func (p *exprParser) skipSpaces() {
+	for p.pos < len(p.s) && (p.s[p.pos] == ' ' || p.s[p.pos] == '\t') {
+		p.pos++
+	}
+}
+
+// parseNumber supports NASM flavours of numbers: 0x1F, 1Fh, 0b101, 101b, 0o17, 17q and decimals
+func parseNumber(tok string) (int, error) {
+	var (
+		lower  = strings.ToLower(strings.ReplaceAll(tok, "_", ""))
+		digits = lower
+		base   = 10
+	)
+
+	switch {
+	case strings.HasPrefix(lower, "0x"), strings.HasPrefix(lower, "0h"):
+		digits, base = lower[2:], 16
+	case strings.HasPrefix(lower, "0b"), strings.HasPrefix(lower, "0y"):
+		digits, base = lower[2:], 2
+	case strings.HasPrefix(lower, "0o"), strings.HasPrefix(lower, "0q"):
+		digits, base = lower[2:], 8
+	case strings.HasPrefix(lower, "0d"), strings.HasPrefix(lower, "0t"):
+		digits, base = lower[2:], 10
+	case strings.HasSuffix(lower, "h"):
+		digits, base = lower[:len(lower)-1], 16
+	case strings.HasSuffix(lower, "b"), strings.HasSuffix(lower, "y"):
+		digits, base = lower[:len(lower)-1], 2
+	case strings.HasSuffix(lower, "q"), strings.HasSuffix(lower, "o"):
+		digits, base = lower[:len(lower)-1], 8
+	case strings.HasSuffix(lower, "d"), strings.HasSuffix(lower, "t"):
+		digits, base = lower[:len(lower)-1], 10
+	}
+
+	v, err := strconv.ParseInt(digits, base, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid number: %s", tok)
+	}
+	return int(v), nil
+}
+
+// parseString parses a quoted string at the start of s and returns its
+// contents and the length of the literal including quotes
+func parseString(s string) (string, int, error) {
+	quote := s[0]
+	end := strings.IndexByte(s[1:], quote)
+	if end == -1 {
+		return "", 0, errors.New("unterminated string")
+	}
+	return s[1 : end+1], end + 2, nil
+}
+
+func identLen(s string) int {
+	if strings.HasPrefix(s, "$$") {
+		return 2
+	}
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if !(isDigit(c) || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || strings.IndexByte("_.$?@#~", c) != -1) {
+			return i
+		}
+		if c == '$' && i > 0 {
+			return i
+		}
+	}
+	return len(s)
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
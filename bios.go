@@ -0,0 +1,585 @@
+package cpu
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+)
+
+// biosData is the physical address of the BIOS data area at 0040:0000
+const biosData = 0x400
+
+// Offsets of the BIOS data area fields
+const (
+	bdaEquipment   = 0x10 // word: installed hardware, bits 4-5 are the initial video mode
+	bdaMemorySize  = 0x13 // word: conventional memory in KiB
+	bdaShiftFlags  = 0x17 // byte: shift, ctrl, alt and lock keys
+	bdaKeyHead     = 0x1A // word: offset of the next key in the keyboard buffer
+	bdaKeyTail     = 0x1C // word: offset of the free slot in the keyboard buffer
+	bdaKeyBuffer   = 0x1E // 16 words: the keyboard buffer
+	bdaVideoMode   = 0x49 // byte
+	bdaColumns     = 0x4A // word
+	bdaPageSize    = 0x4C // word: bytes of video memory per page
+	bdaPageStart   = 0x4E // word: offset of the active page
+	bdaCursor      = 0x50 // 8 words: column and row of the cursor of each page
+	bdaCursorShape = 0x60 // word: the end and the start scan lines
+	bdaActivePage  = 0x62 // byte
+	bdaCRTCPort    = 0x63 // word: 3D4h for color adapters, 3B4h for monochrome
+	bdaTicks       = 0x6C // dword: timer ticks since midnight
+	bdaMidnight    = 0x70 // byte: set when the ticks wrap over midnight
+	bdaRows        = 0x84 // byte: rows of the screen minus one
+	bdaKeyStart    = 0x80 // word: offset of the keyboard buffer start
+	bdaKeyEnd      = 0x82 // word: offset right after the keyboard buffer
+)
+
+const (
+	// clocksPerTick is the period of IRQ0: the PIT runs at a quarter of the
+	// 4.77 MHz CPU clock and counts 65536 pulses
+	clocksPerTick = pitDivisor * 0x10000
+	// ticksPerDay is the number of ticks the counter wraps at
+	ticksPerDay = 0x1800B0
+	// screenRows is the number of rows of all text modes
+	screenRows = 25
+	// blank is a space with the light gray on black attribute
+	blank = 0x0720
+)
+
+// VideoMode is a mode of the CGA or MDA adapter
+type VideoMode struct {
+	Columns  int
+	Text     bool
+	Segment  uint16
+	PageSize uint16
+}
+
+var videoModes = map[uint8]VideoMode{
+	0x00: {Columns: 40, Text: true, Segment: 0xB800, PageSize: 0x0800},
+	0x01: {Columns: 40, Text: true, Segment: 0xB800, PageSize: 0x0800},
+	0x02: {Columns: 80, Text: true, Segment: 0xB800, PageSize: 0x1000},
+	0x03: {Columns: 80, Text: true, Segment: 0xB800, PageSize: 0x1000},
+	0x04: {Columns: 40, Segment: 0xB800, PageSize: 0x4000},
+	0x05: {Columns: 40, Segment: 0xB800, PageSize: 0x4000},
+	0x06: {Columns: 80, Segment: 0xB800, PageSize: 0x4000},
+	0x07: {Columns: 80, Text: true, Segment: 0xB000, PageSize: 0x1000},
+}
+
+// Key is a keystroke as INT 16h returns it: the scan code in the high byte
+// and the ASCII code in the low one
+type Key uint16
+
+// ErrNoKeys is returned by INT 16h when a program waits for a key, but the
+// script has no more keys
+var ErrNoKeys = errors.New("the keyboard script is over")
+
+// BIOS emulates the video, keyboard and timer services of the PC BIOS in Go
+// and keeps the BIOS data area consistent with them. Install it on a machine
+// before running a program.
+type BIOS struct {
+	// Stdout receives the characters of the teletype output, nil discards them
+	Stdout io.Writer
+	// Keys are the scripted keystrokes. They go to the keyboard buffer of the
+	// BIOS data area as it has room
+	Keys []Key
+	// Keyboard is polled by INT 16h when the keyboard buffer is empty. Its
+	// scan codes go to the buffer on IRQ1 too
+	Keyboard *Keyboard
+
+	// tickClocks are the clocks of the last timer tick. They count the ticks
+	// until IRQ0 comes, then INT 08h does, which timerIRQ is set for
+	tickClocks int
+	timerIRQ   bool
+}
+
+// Install makes the machine call the BIOS on INT 10h, 16h and 1Ah and on
+// the timer and keyboard interrupts 08h and 09h, fills the BIOS data area and sets the 80x25
+// color text mode
+func (b *BIOS) Install(m *Machine) {
+	if m.Interrupts == nil {
+		m.Interrupts = make(map[uint8]InterruptHandler)
+	}
+	m.Interrupts[0x08] = b.int08
+	m.Interrupts[0x09] = b.int09
+	m.Interrupts[0x10] = b.int10
+	m.Interrupts[0x16] = b.int16
+	m.Interrupts[0x1A] = b.int1A
+	m.Devices = append(m.Devices, b)
+
+	// NOTE: 80x25 color, no floppies
+	m.writeWord(biosData+bdaEquipment, 0b10<<4)
+	m.writeWord(biosData+bdaMemorySize, 640)
+	m.writeWord(biosData+bdaKeyStart, bdaKeyBuffer)
+	m.writeWord(biosData+bdaKeyEnd, bdaKeyBuffer+32)
+	m.writeWord(biosData+bdaKeyHead, bdaKeyBuffer)
+	m.writeWord(biosData+bdaKeyTail, bdaKeyBuffer)
+	m.writeWord(biosData+bdaCursorShape, 0x0607)
+	b.tickClocks = m.Clocks
+	b.fillKeyBuffer(m)
+
+	setMode(m, 0x03)
+	clearScreen(m)
+}
+
+// Step counts timer ticks while there is no IRQ0 and moves scripted keys to
+// the keyboard buffer
+func (b *BIOS) Step(m *Machine) {
+	for !b.timerIRQ && m.Clocks-b.tickClocks >= clocksPerTick {
+		b.tickClocks += clocksPerTick
+		tick(m)
+	}
+
+	b.fillKeyBuffer(m)
+}
+
+// tick counts a timer tick in the BIOS data area
+func tick(m *Machine) {
+	ticks := uint32(m.readWord(biosData+bdaTicks)) | uint32(m.readWord(biosData+bdaTicks+2))<<16
+	ticks++
+	if ticks >= ticksPerDay {
+		ticks = 0
+		m.Memory[biosData+bdaMidnight] = 1
+	}
+	m.writeWord(biosData+bdaTicks, uint16(ticks))
+	m.writeWord(biosData+bdaTicks+2, uint16(ticks>>16))
+}
+
+// int08 serves IRQ0: it counts the timer tick and acknowledges it to the PIC
+func (b *BIOS) int08(m *Machine) error {
+	b.timerIRQ = true
+	tick(m)
+	m.out(picCommand, picEOI)
+	return nil
+}
+
+func (b *BIOS) int10(m *Machine) error {
+	switch ah := m.Reg(AH); ah {
+	case 0x00:
+		// NOTE: bit 7 keeps the video memory
+		mode := uint8(m.Reg(AL))
+		if _, ok := videoModes[mode&0x7F]; !ok {
+			return fmt.Errorf("unsupported video mode %02Xh", mode&0x7F)
+		}
+		setMode(m, mode&0x7F)
+		if mode&0x80 == 0 {
+			clearScreen(m)
+		}
+		return nil
+	case 0x01:
+		m.writeWord(biosData+bdaCursorShape, m.Reg(CX))
+		return nil
+	case 0x02:
+		setCursor(m, uint8(m.Reg(BH)), uint8(m.Reg(DL)), uint8(m.Reg(DH)))
+		return nil
+	case 0x03:
+		col, row := cursor(m, uint8(m.Reg(BH)))
+		m.SetReg(DL, uint16(col))
+		m.SetReg(DH, uint16(row))
+		m.SetReg(CX, m.readWord(biosData+bdaCursorShape))
+		return nil
+	case 0x0E:
+		return b.teletype(m, byte(m.Reg(AL)))
+	case 0x0F:
+		m.SetReg(AL, uint16(m.Memory[biosData+bdaVideoMode]))
+		m.SetReg(AH, m.readWord(biosData+bdaColumns))
+		m.SetReg(BH, uint16(m.Memory[biosData+bdaActivePage]))
+		return nil
+	default:
+		return fmt.Errorf("unsupported INT 10h function AH=%02Xh", ah)
+	}
+}
+
+func setMode(m *Machine, mode uint8) {
+	vm := videoModes[mode]
+	crtc := uint16(0x3D4)
+	if vm.Segment == 0xB000 {
+		crtc = 0x3B4
+	}
+
+	m.Memory[biosData+bdaVideoMode] = mode
+	m.writeWord(biosData+bdaColumns, uint16(vm.Columns))
+	m.writeWord(biosData+bdaPageSize, vm.PageSize)
+	m.writeWord(biosData+bdaPageStart, 0)
+	m.Memory[biosData+bdaActivePage] = 0
+	m.writeWord(biosData+bdaCRTCPort, crtc)
+	m.Memory[biosData+bdaRows] = screenRows - 1
+	for page := range uint8(8) {
+		setCursor(m, page, 0, 0)
+	}
+}
+
+// Mode returns the current video mode from the BIOS data area
+func (m *Machine) Mode() (uint8, VideoMode) {
+	mode := m.Memory[biosData+bdaVideoMode]
+	return mode, videoModes[mode]
+}
+
+// clearScreen fills the video memory with blanks in text modes and zeroes in
+// graphics: 16 KiB of CGA or 4 KiB of MDA
+func clearScreen(m *Machine) {
+	_, vm := m.Mode()
+	fill, size := uint16(blank), uint32(0x4000)
+	if !vm.Text {
+		fill = 0
+	}
+	if vm.Segment == 0xB000 {
+		size = 0x1000
+	}
+	base := uint32(vm.Segment) << 4
+	for i := uint32(0); i < size; i += 2 {
+		m.writeWord(base+i, fill)
+	}
+}
+
+// teletype writes a character at the cursor of the active page and moves it,
+// scrolling the screen up at the bottom. BEL, BS, LF and CR are controls
+func (b *BIOS) teletype(m *Machine, c byte) error {
+	var (
+		page     = m.Memory[biosData+bdaActivePage]
+		col, row = cursor(m, page)
+		_, vm    = m.Mode()
+	)
+
+	switch c {
+	case '\a':
+	case '\b':
+		if col > 0 {
+			col--
+		}
+	case '\n':
+		row++
+	case '\r':
+		col = 0
+	default:
+		if vm.Text {
+			m.Memory[cell(m, page, col, row)] = c
+		}
+		col++
+		if int(col) == vm.Columns {
+			col = 0
+			row++
+		}
+	}
+
+	if row == screenRows {
+		row--
+		if vm.Text {
+			scroll(m, page)
+		}
+	}
+	setCursor(m, page, col, row)
+
+	if b.Stdout == nil {
+		return nil
+	}
+	_, err := b.Stdout.Write([]byte{c})
+	return err
+}
+
+// scroll moves the lines of a text page up by one and blanks the last one
+func scroll(m *Machine, page uint8) {
+	_, vm := m.Mode()
+	for row := range uint8(screenRows) {
+		for col := range uint8(vm.Columns) {
+			v := uint16(blank)
+			if row < screenRows-1 {
+				v = m.readWord(cell(m, page, col, row+1))
+			}
+			m.writeWord(cell(m, page, col, row), v)
+		}
+	}
+}
+
+// cell returns the physical address of a character in a text page
+func cell(m *Machine, page, col, row uint8) uint32 {
+	_, vm := m.Mode()
+	offset := uint32(page)*uint32(vm.PageSize) + (uint32(row)*uint32(vm.Columns)+uint32(col))*2
+	return uint32(vm.Segment)<<4 + offset
+}
+
+func cursor(m *Machine, page uint8) (col, row uint8) {
+	at := biosData + bdaCursor + 2*uint32(page%8)
+	return m.Memory[at], m.Memory[at+1]
+}
+
+func setCursor(m *Machine, page, col, row uint8) {
+	at := biosData + bdaCursor + 2*uint32(page%8)
+	m.Memory[at], m.Memory[at+1] = col, row
+}
+
+// int09 serves IRQ1: it reads the scan code, acknowledges it to the keyboard
+// and the PIC and puts the keystroke to the keyboard buffer
+func (b *BIOS) int09(m *Machine) error {
+	scanCode(m, m.in(kbdData))
+
+	portB := m.in(ppiPortB)
+	m.out(ppiPortB, portB|portBClearKeyboard)
+	m.out(ppiPortB, portB)
+	m.out(picCommand, picEOI)
+	return nil
+}
+
+// Scan codes of the shift keys
+const (
+	scanCtrl       = 0x1D
+	scanLeftShift  = 0x2A
+	scanRightShift = 0x36
+	scanAlt        = 0x38
+	scanCapsLock   = 0x3A
+	// scanBreak is set in the scan code of a key release
+	scanBreak = 0x80
+)
+
+// Shift flags of the BIOS data area
+const (
+	shiftRight = 1 << 0
+	shiftLeft  = 1 << 1
+	shiftCtrl  = 1 << 2
+	shiftAlt   = 1 << 3
+	shiftCaps  = 1 << 6
+)
+
+// shiftKeys are the shift flags of the keys held down
+var shiftKeys = map[byte]byte{
+	scanRightShift: shiftRight,
+	scanLeftShift:  shiftLeft,
+	scanCtrl:       shiftCtrl,
+	scanAlt:        shiftAlt,
+}
+
+// scanCode translates a scan code of the US layout: it keeps the shift flags
+// and puts a keystroke to the keyboard buffer on a key press. The keys which
+// don't type characters have the zero ASCII code
+func scanCode(m *Machine, code byte) {
+	var (
+		flags   = &m.Memory[biosData+bdaShiftFlags]
+		pressed = code&scanBreak == 0
+		scan    = code &^ scanBreak
+	)
+
+	if flag, ok := shiftKeys[scan]; ok {
+		if pressed {
+			*flags |= flag
+		} else {
+			*flags &^= flag
+		}
+		return
+	}
+	if !pressed {
+		return
+	}
+	if scan == scanCapsLock {
+		*flags ^= shiftCaps
+		return
+	}
+
+	var (
+		chars   = keyChars[scan]
+		shifted = *flags&(shiftLeft|shiftRight) != 0
+		c       = chars[boolToInt(shifted)]
+	)
+	if *flags&shiftCaps != 0 && isLetter(c) {
+		c = chars[boolToInt(!shifted)]
+	}
+	switch {
+	case *flags&shiftAlt != 0:
+		c = 0
+	case *flags&shiftCtrl != 0 && isLetter(c):
+		c &= 0x1F
+	}
+	putKey(m, Key(scan)<<8|Key(c))
+}
+
+func isLetter(c byte) bool {
+	return c|0x20 >= 'a' && c|0x20 <= 'z'
+}
+
+// pollKeyboard translates the scan codes of the keyboard until there is a key
+// in the keyboard buffer, as INT 16h waits for one
+func (b *BIOS) pollKeyboard(m *Machine) {
+	if b.Keyboard == nil {
+		return
+	}
+	for {
+		if _, ok := readKey(m, false); ok {
+			return
+		}
+		code, ok := b.Keyboard.Poll()
+		if !ok {
+			return
+		}
+		scanCode(m, code)
+	}
+}
+
+func (b *BIOS) int16(m *Machine) error {
+	switch ah := m.Reg(AH); ah {
+	case 0x00, 0x10:
+		// Read a key
+		b.fillKeyBuffer(m)
+		b.pollKeyboard(m)
+		key, ok := readKey(m, true)
+		if !ok {
+			return ErrNoKeys
+		}
+		m.SetReg(AX, uint16(key))
+		b.fillKeyBuffer(m)
+		return nil
+	case 0x01, 0x11:
+		// Peek a key: ZF is set if there is none
+		b.fillKeyBuffer(m)
+		b.pollKeyboard(m)
+		key, ok := readKey(m, false)
+		if ok {
+			m.SetReg(AX, uint16(key))
+		}
+		m.setFlag(FlagZF, !ok)
+		return nil
+	case 0x02:
+		m.SetReg(AL, uint16(m.Memory[biosData+bdaShiftFlags]))
+		return nil
+	default:
+		return fmt.Errorf("unsupported INT 16h function AH=%02Xh", ah)
+	}
+}
+
+// fillKeyBuffer moves scripted keys to the keyboard buffer while it has room
+func (b *BIOS) fillKeyBuffer(m *Machine) {
+	for len(b.Keys) > 0 && putKey(m, b.Keys[0]) {
+		b.Keys = b.Keys[1:]
+	}
+}
+
+// putKey adds the key to the keyboard buffer, it's lost if the buffer is full
+func putKey(m *Machine, key Key) bool {
+	var (
+		head = m.readWord(biosData + bdaKeyHead)
+		tail = m.readWord(biosData + bdaKeyTail)
+		next = nextKeySlot(m, tail)
+	)
+	// NOTE: one slot always stays free, otherwise a full buffer looks empty
+	if next == head {
+		return false
+	}
+	m.writeWord(biosData+uint32(tail), uint16(key))
+	m.writeWord(biosData+bdaKeyTail, next)
+	return true
+}
+
+// readKey returns the key at the head of the keyboard buffer and removes it if remove is set
+func readKey(m *Machine, remove bool) (Key, bool) {
+	head := m.readWord(biosData + bdaKeyHead)
+	if head == m.readWord(biosData+bdaKeyTail) {
+		return 0, false
+	}
+	key := Key(m.readWord(biosData + uint32(head)))
+	if remove {
+		m.writeWord(biosData+bdaKeyHead, nextKeySlot(m, head))
+	}
+	return key, true
+}
+
+func nextKeySlot(m *Machine, slot uint16) uint16 {
+	slot += 2
+	if slot >= m.readWord(biosData+bdaKeyEnd) {
+		slot = m.readWord(biosData + bdaKeyStart)
+	}
+	return slot
+}
+
+func (b *BIOS) int1A(m *Machine) error {
+	switch ah := m.Reg(AH); ah {
+	case 0x00:
+		// Read the ticks to CX:DX, AL tells whether midnight has passed since the last read
+		m.SetReg(CX, m.readWord(biosData+bdaTicks+2))
+		m.SetReg(DX, m.readWord(biosData+bdaTicks))
+		m.SetReg(AL, uint16(m.Memory[biosData+bdaMidnight]))
+		m.Memory[biosData+bdaMidnight] = 0
+		return nil
+	case 0x01:
+		m.writeWord(biosData+bdaTicks+2, m.Reg(CX))
+		m.writeWord(biosData+bdaTicks, m.Reg(DX))
+		m.Memory[biosData+bdaMidnight] = 0
+		return nil
+	default:
+		return fmt.Errorf("unsupported INT 1Ah function AH=%02Xh", ah)
+	}
+}
+
+// keyRows are the character keys of the US layout in rows of consecutive
+// scan codes: the characters without and with shift
+var keyRows = []struct {
+	scan         byte
+	chars, shift string
+}{
+	{0x02, "1234567890-=", "!@#$%^&*()_+"},
+	{0x10, "qwertyuiop[]", "QWERTYUIOP{}"},
+	{0x1E, "asdfghjkl;'`", "ASDFGHJKL:\"~"},
+	{0x2B, `\zxcvbnm,./`, "|ZXCVBNM<>?"},
+}
+
+// controlKeys are the keys which type the same character with shift
+var controlKeys = map[byte]byte{0x1B: 0x01, '\b': 0x0E, '\t': 0x0F, '\r': 0x1C, ' ': 0x39}
+
+// scanCodes are the scan codes of the US layout keys by the characters they type
+var scanCodes = func() map[byte]byte {
+	codes := maps.Clone(controlKeys)
+	for _, r := range keyRows {
+		for i := range len(r.chars) {
+			codes[r.chars[i]] = r.scan + byte(i)
+			codes[r.shift[i]] = r.scan + byte(i)
+		}
+	}
+	return codes
+}()
+
+// keyChars are the characters the keys type without and with shift by their scan codes
+var keyChars = func() map[byte][2]byte {
+	chars := make(map[byte][2]byte)
+	for c, scan := range controlKeys {
+		chars[scan] = [2]byte{c, c}
+	}
+	for _, r := range keyRows {
+		for i := range len(r.chars) {
+			chars[r.scan+byte(i)] = [2]byte{r.chars[i], r.shift[i]}
+		}
+	}
+	return chars
+}()
+
+// KeysOf turns text into keystrokes of the US layout. "\n" is Enter, the
+// characters which aren't on the keyboard have the zero scan code
+func KeysOf(text string) []Key {
+	keys := make([]Key, 0, len(text))
+	for i := range len(text) {
+		c := text[i]
+		if c == '\n' {
+			c = '\r'
+		}
+		keys = append(keys, Key(scanCodes[c])<<8|Key(c))
+	}
+	return keys
+}
+
+// ScanCodesOf turns text into presses and releases of the US layout keys,
+// with the left shift held for shifted characters. "\n" is Enter, the
+// characters which aren't on the keyboard are skipped
+func ScanCodesOf(text string) []byte {
+	var codes []byte
+	for i := range len(text) {
+		c := text[i]
+		if c == '\n' {
+			c = '\r'
+		}
+		scan, ok := scanCodes[c]
+		if !ok {
+			continue
+		}
+
+		if shifted := keyChars[scan][0] != c; shifted {
+			codes = append(codes, scanLeftShift, scan, scan|scanBreak, scanLeftShift|scanBreak)
+		} else {
+			codes = append(codes, scan, scan|scanBreak)
+		}
+	}
+	return codes
+}
@@ -0,0 +1,141 @@
+package cpu_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	cpu "cpu8086"
+)
+
+// runBIOS runs a program at 0000:1000 with the BIOS installed
+func runBIOS(t *testing.T, bios *cpu.BIOS, src string) (*cpu.Machine, error) {
+	t.Helper()
+
+	m := cpu.NewMachine()
+	bios.Install(m)
+	_, err := runProgram(t, m, 0x1000, src)
+	return m, err
+}
+
+func TestBIOSTeletype(t *testing.T) {
+	var (
+		out  strings.Builder
+		bios = cpu.BIOS{Stdout: &out}
+	)
+	m, err := runBIOS(t, &bios, `
+mov ah, 0eh
+mov al, 'H'
+int 10h
+mov al, 'i'
+int 10h
+mov al, 13
+int 10h
+mov al, 10
+int 10h
+mov ah, 3
+mov bh, 0
+int 10h
+`)
+	require.NoError(t, err)
+	require.Equal(t, "Hi\r\n", out.String())
+
+	const screen = 0xB8000
+	require.Equal(t, []byte{'H', 0x07, 'i', 0x07, ' ', 0x07}, m.Memory[screen:screen+6])
+	// NOTE: the cursor is at the start of the second row
+	require.Equal(t, uint16(0x0100), m.Reg(cpu.DX))
+	require.Equal(t, uint16(0x0607), m.Reg(cpu.CX))
+	require.Equal(t, []byte{0, 1}, m.Memory[0x450:0x452])
+}
+
+func TestBIOSScroll(t *testing.T) {
+	var bios cpu.BIOS
+	m, err := runBIOS(t, &bios, `
+mov ah, 2
+mov bh, 0
+mov dx, 0x1800
+int 10h
+mov ah, 0eh
+mov al, 10
+int 10h
+`)
+	require.NoError(t, err)
+	require.Equal(t, []byte{0, 24}, m.Memory[0x450:0x452])
+
+	m.Memory[0xB8000+80*2] = 'X'
+	m.SetReg(cpu.AX, 0x0e0a)
+	_, err = runProgram(t, m, 0x1000, "int 10h\n")
+	require.NoError(t, err)
+
+	require.Equal(t, byte('X'), m.Memory[0xB8000])
+	require.Equal(t, byte(' '), m.Memory[0xB8000+80*2])
+}
+
+func TestBIOSVideoMode(t *testing.T) {
+	var bios cpu.BIOS
+	m, err := runBIOS(t, &bios, `
+mov ax, 7
+int 10h
+mov ah, 0fh
+int 10h
+`)
+	require.NoError(t, err)
+	require.Equal(t, uint16(0x5007), m.Reg(cpu.AX))
+	require.Equal(t, uint16(0x3b4), uint16(m.Memory[0x463])|uint16(m.Memory[0x464])<<8)
+	require.Equal(t, []byte{' ', 0x07}, m.Memory[0xB0000:0xB0002])
+
+	_, err = runBIOS(t, &bios, "mov ax, 0x13\nint 10h\n")
+	require.EqualError(t, err, "offset 0x1003: int 16: unsupported video mode 13h")
+}
+
+func TestBIOSKeyboard(t *testing.T) {
+	bios := cpu.BIOS{Keys: cpu.KeysOf("a\n")}
+	m, err := runBIOS(t, &bios, `
+mov ah, 1
+int 16h
+mov bx, ax
+mov ah, 0
+int 16h
+mov cx, ax
+mov ah, 0
+int 16h
+mov dx, ax
+mov ah, 1
+int 16h
+`)
+	require.NoError(t, err)
+	require.Equal(t, uint16(0x1e61), m.Reg(cpu.BX))
+	require.Equal(t, uint16(0x1e61), m.Reg(cpu.CX))
+	require.Equal(t, uint16(0x1c0d), m.Reg(cpu.DX))
+	require.NotZero(t, m.Flags&cpu.FlagZF)
+	// NOTE: the keyboard buffer is empty: the head caught up with the tail
+	require.Equal(t, m.Memory[0x41a:0x41c], m.Memory[0x41c:0x41e])
+
+	_, err = runBIOS(t, &bios, "mov ah, 0\nint 16h\n")
+	require.ErrorIs(t, err, cpu.ErrNoKeys)
+}
+
+func TestBIOSKeyBuffer(t *testing.T) {
+	bios := cpu.BIOS{Keys: cpu.KeysOf(strings.Repeat("x", 20))}
+	m, err := runBIOS(t, &bios, "hlt\n")
+	require.NoError(t, err)
+
+	// NOTE: the buffer has room for 15 keys
+	require.Len(t, bios.Keys, 5)
+	require.Equal(t, []byte{0x1e, 0x00, 0x3c, 0x00}, m.Memory[0x41a:0x41e])
+}
+
+func TestBIOSTicks(t *testing.T) {
+	var bios cpu.BIOS
+	m := cpu.NewMachine()
+	bios.Install(m)
+	// NOTE: three periods of IRQ0 at 4.77 MHz
+	m.Clocks += 3 * 65536 * 4
+	_, err := runProgram(t, m, 0x1000, "mov ah, 0\nint 1ah\n")
+	require.NoError(t, err)
+
+	require.Equal(t, uint16(0), m.Reg(cpu.CX))
+	require.Equal(t, uint16(3), m.Reg(cpu.DX))
+	require.Equal(t, []byte{3, 0, 0, 0}, m.Memory[0x46c:0x470])
+}
@@ -0,0 +1,174 @@
+package cpu
+
+import "fmt"
+
+// BusTiming models the 8088 bus instead of taking clocks from the manual
+// tables. Every bus cycle is T1-T4 plus wait states and moves one byte. The
+// BIU fills the 4-byte prefetch queue whenever the EU doesn't need the bus,
+// the EU waits for instruction bytes when the queue is empty and for the
+// bus when its memory operand competes with a prefetch. Taken jumps flush
+// the queue.
+//
+// The EU time of an instruction is its table clocks without bus transfers:
+// the EA calculation goes first, then reads, the rest of the EU time and writes.
+type BusTiming struct {
+	// WaitStates are clocks added to every bus cycle (Tw between T3 and T4)
+	WaitStates int
+	// Clocks is the total of clocks of executed instructions, interrupt entries
+	// and HLT
+	Clocks int
+	// BusCycles counts bus cycles including prefetches
+	BusCycles int
+
+	// queue is the number of bytes in the prefetch queue
+	queue int
+	// fetching is set while the BIU fetches a byte, which is done at fetchDone
+	fetching  bool
+	fetchDone int
+	// busFree is when the bus finishes its last started cycle
+	busFree int
+	// roomAt is when the queue got room after being full
+	roomAt int
+}
+
+const (
+	// busCycleClocks are T1, T2, T3 and T4
+	busCycleClocks    = 4
+	prefetchQueueSize = 4
+)
+
+// Queue returns the number of bytes in the prefetch queue
+func (b *BusTiming) Queue() int {
+	return b.queue
+}
+
+func (b *BusTiming) cycle() int {
+	return busCycleClocks + b.WaitStates
+}
+
+// step accounts an executed instruction of size bytes with table clocks c and
+// returns the clocks it actually took
+func (b *BusTiming) step(size int, c Clocks, jump, taken bool) int {
+	start := b.Clocks
+
+	for range size {
+		b.consume()
+	}
+
+	if jump {
+		eu := c.Base
+		if taken {
+			// NOTE: the table counts the fetch of the target, the model fetches it after the flush
+			eu -= busCycleClocks
+		}
+		b.execute(eu)
+		if taken {
+			b.flush()
+		}
+		return b.Clocks - start
+	}
+
+	// NOTE: the tables count a bus cycle per transfer of 8086
+	eu := c.Base - busCycleClocks*(c.reads+c.writes)
+	if taken {
+		// NOTE: RET, INT and IRET transfer control as a taken jump does
+		eu -= busCycleClocks
+	}
+	eu = max(eu, 0)
+	bytes := 1
+	if c.word {
+		bytes = 2
+	}
+
+	b.execute(c.EA)
+	b.transfer(c.reads * bytes)
+	b.execute(eu)
+	b.transfer(c.writes * bytes)
+	if taken {
+		b.flush()
+	}
+
+	return b.Clocks - start
+}
+
+// idle accounts clocks of the halted CPU and returns them
+func (b *BusTiming) idle(clocks int) int {
+	b.execute(clocks)
+	return clocks
+}
+
+// prefetch runs the BIU in the background up to the clock t
+func (b *BusTiming) prefetch(t int) {
+	for {
+		if b.fetching {
+			if b.fetchDone > t {
+				return
+			}
+			b.fetching = false
+			b.queue++
+		}
+
+		if b.queue == prefetchQueueSize {
+			return
+		}
+
+		start := max(b.busFree, b.roomAt)
+		if start >= t {
+			return
+		}
+		b.fetching = true
+		b.fetchDone = start + b.cycle()
+		b.busFree = b.fetchDone
+		b.BusCycles++
+	}
+}
+
+// consume takes an instruction byte from the queue waiting for it if needed
+func (b *BusTiming) consume() {
+	b.prefetch(b.Clocks)
+	for b.queue == 0 {
+		if !b.fetching {
+			// NOTE: the bus is still busy, the fetch starts as soon as it's free
+			b.prefetch(max(b.busFree, b.roomAt) + 1)
+		}
+		b.Clocks = max(b.Clocks, b.fetchDone)
+		b.prefetch(b.Clocks)
+	}
+
+	if b.queue == prefetchQueueSize {
+		b.roomAt = b.Clocks
+	}
+	b.queue--
+}
+
+// execute spends EU clocks, the BIU prefetches meanwhile
+func (b *BusTiming) execute(clocks int) {
+	b.Clocks += clocks
+	b.prefetch(b.Clocks)
+}
+
+// transfer moves bytes of a memory operand. A prefetch in progress is
+// finished first
+func (b *BusTiming) transfer(bytes int) {
+	for range bytes {
+		b.prefetch(b.Clocks)
+		b.Clocks = max(b.Clocks, b.busFree) + b.cycle()
+		b.busFree = b.Clocks
+		b.BusCycles++
+	}
+	b.prefetch(b.Clocks)
+}
+
+// flush drops the queue. A byte being fetched is dropped when it arrives
+func (b *BusTiming) flush() {
+	b.prefetch(b.Clocks)
+	b.fetching = false
+	b.queue = 0
+	b.roomAt = b.Clocks
+}
+
+// annotation formats actual clocks of the last instruction next to its table
+// clocks: "Bus: +21 = 60 (table +17, queue 2)"
+func (b *BusTiming) annotation(actual, table int) string {
+	return fmt.Sprintf("Bus: +%d = %d (table +%d, queue %d)", actual, b.Clocks, table, b.queue)
+}
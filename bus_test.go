@@ -0,0 +1,131 @@
+package cpu_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	cpu "cpu8086"
+	"cpu8086/asm"
+)
+
+func TestBusTiming(t *testing.T) {
+	program, err := asm.Assemble(`
+mov ax, bx
+mov ax, bx
+mov ax, bx
+mov dx, [bx + 1000]
+add ax, 1
+hlt
+`)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		waitStates int
+		want       string
+		cycles     int
+	}{
+		// NOTE: 14 bytes of the program, 2 of the operand and bytes prefetched past hlt
+		//
+		// By hand: the queue is empty, so the first mov waits for 2 fetches
+		// of 4 clocks and executes for 2 clocks, 10 in total. The BIU can't
+		// keep up with 2 clocks of EU time per 2 bytes after that, so every
+		// next mov takes the 8 clocks of its fetches
+		{
+			name: "no wait states",
+			want: `mov ax, bx ; Bus: +10 = 10 (table +2, queue 0) | ip:0x0->0x2 
+mov ax, bx ; Bus: +8 = 18 (table +2, queue 0) | ip:0x2->0x4 
+mov ax, bx ; Bus: +8 = 26 (table +2, queue 0) | ip:0x4->0x6 
+mov dx, word [bx+1000] ; Bus: +38 = 64 (table +21, queue 4) | ip:0x6->0xa 
+add ax, 1 ; Bus: +4 = 68 (table +4, queue 2) | ax:0x0->0x1 ip:0xa->0xd 
+hlt ; Bus: +2 = 70 (table +2, queue 1) | ip:0xd->0xe 
+`,
+			cycles: 18,
+		},
+		// NOTE: a fetch is 5 clocks, so mov takes 2*5+2 = 12 clocks and
+		// then 10 clocks
+		{
+			name:       "a wait state",
+			waitStates: 1,
+			want: `mov ax, bx ; Bus: +12 = 12 (table +2, queue 0) | ip:0x0->0x2 
+mov ax, bx ; Bus: +10 = 22 (table +2, queue 0) | ip:0x2->0x4 
+mov ax, bx ; Bus: +10 = 32 (table +2, queue 0) | ip:0x4->0x6 
+mov dx, word [bx+1000] ; Bus: +42 = 74 (table +21, queue 2) | ip:0x6->0xa 
+add ax, 1 ; Bus: +5 = 79 (table +4, queue 0) | ax:0x0->0x1 ip:0xa->0xd 
+hlt ; Bus: +3 = 82 (table +2, queue 0) | ip:0xd->0xe 
+`,
+			cycles: 17,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := cpu.NewMachine()
+			m.Model = cpu.Model8088
+			m.Bus = &cpu.BusTiming{WaitStates: tt.waitStates}
+			m.Load(program, 0)
+
+			var b strings.Builder
+			require.NoError(t, m.Trace(&b, 100, cpu.WithTraceBus()))
+			require.Equal(t, tt.want, b.String())
+			require.Equal(t, tt.cycles, m.Bus.BusCycles)
+			// NOTE: the tables are the same whatever the bus is
+			require.Equal(t, 33, m.Clocks)
+		})
+	}
+}
+
+func TestBusInterrupt(t *testing.T) {
+	program, err := asm.Assemble(`
+org 0x100
+sti
+hlt
+`)
+	require.NoError(t, err)
+
+	m := cpu.NewMachine()
+	m.Model = cpu.Model8088
+	m.Bus = &cpu.BusTiming{}
+	pic := &cpu.PIC{}
+	pic.Install(m)
+	m.Load(program, 0x100)
+	m.SetReg(cpu.SP, 0x1000)
+	// NOTE: IRQ0 enters hlt at 0000:0101
+	m.Memory[8*4] = 0x01
+	m.Memory[8*4+1] = 0x01
+	pic.Raise(0)
+
+	_, err = m.Step()
+	require.NoError(t, err)
+	require.Equal(t, uint16(0x101), m.IP)
+
+	// NOTE: sti takes 2 clocks and the INTR sequence 61 plus 20 of the
+	// 8088 penalty for 5 word transfers: 2+61+20 = 83
+	require.Equal(t, 83, m.Clocks)
+
+	// NOTE: by hand: sti is fetched by 4 and executed by 6. The fetch of
+	// the next byte started at 4 keeps the bus till 8, then 2 words of the
+	// vector take 4 bus cycles till 24. The EU time is 61 clocks less 4 per
+	// transfer of a word and 4 for the flush: 61-5*4-4 = 37 till 61. The BIU
+	// refills the queue meanwhile, so the 3 words pushed go straight to the
+	// bus, 6 cycles till 85
+	require.Equal(t, 85, m.Bus.Clocks)
+	require.Equal(t, 0, m.Bus.Queue())
+}
+
+func TestBusIdle(t *testing.T) {
+	m := cpu.NewMachine()
+	m.Model = cpu.Model8088
+	m.Bus = &cpu.BusTiming{}
+	cpu.NewPC(m)
+	_, err := runProgram(t, m, 0x1000, "sti\nhlt\ncli\n")
+	require.NoError(t, err)
+
+	// NOTE: by hand: sti takes 4+2 = 6 clocks instead of 2 and hlt waits
+	// for its byte till 8 and takes 8+2-6 = 4 clocks instead of 2. The
+	// halted CPU waits for an interrupt as long as the tables tell
+	require.Equal(t, m.Clocks+6, m.Bus.Clocks)
+	require.Equal(t, 4, m.Bus.Queue())
+}
@@ -0,0 +1,235 @@
+// Package cfg builds control-flow graphs of decoded 8086 programs.
+package cfg
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	cpu "cpu8086"
+)
+
+// EdgeKind tells which way the control goes from a block
+type EdgeKind uint8
+
+const (
+	// EdgeFallThrough goes to the next instruction
+	EdgeFallThrough EdgeKind = iota + 1
+	// EdgeTaken goes to the target of a jump
+	EdgeTaken
+)
+
+func (k EdgeKind) String() string {
+	switch k {
+	case EdgeFallThrough:
+		return "fall-through"
+	case EdgeTaken:
+		return "taken"
+	default:
+		return fmt.Sprintf("EdgeKind(%d)", uint8(k))
+	}
+}
+
+// Edge connects a block with one of its successors
+type Edge struct {
+	// To is the start of the successor block
+	To   int
+	Kind EdgeKind
+	// Cond is the condition under which the edge is followed, e.g. "cx != 0"
+	// for a taken LOOP (cx is decremented by then). Empty when the edge is
+	// unconditional
+	Cond string
+}
+
+// Inst is an instruction of a block
+type Inst struct {
+	Offset int
+	Size   int
+	Inst   cpu.Instruction
+}
+
+// Block is a basic block: a run of instructions entered only at the first one
+// and left only after the last one
+type Block struct {
+	// Start and End are offsets of the block, End is exclusive
+	Start, End int
+	Insts      []Inst
+	Succs      []Edge
+}
+
+// Graph is a control-flow graph. Blocks are sorted by their start, the first
+// one is the entry
+type Graph struct {
+	Blocks []*Block
+}
+
+// Build decodes the whole stream loaded at origin and splits it into basic
+// blocks. Jumps out of the stream or into the middle of an instruction don't
+// make edges
+func Build(stream []byte, origin int) (*Graph, error) {
+	var insts []Inst
+	for ip := 0; ip < len(stream); {
+		inst, n, err := cpu.Decode(stream[ip:])
+		if err != nil {
+			var decodeErr *cpu.DecodeError
+			if errors.As(err, &decodeErr) {
+				decodeErr.Offset = origin + ip
+			}
+			return nil, err
+		}
+		insts = append(insts, Inst{Offset: origin + ip, Size: n, Inst: inst})
+		ip += n
+	}
+
+	starts := make(map[int]bool, len(insts))
+	for _, in := range insts {
+		starts[in.Offset] = true
+	}
+
+	// NOTE: a block starts at the entry, at a jump target and right after a
+	// jump, HLT, RET or IRET
+	leaders := map[int]bool{origin: true}
+	for _, in := range insts {
+		next := in.Offset + in.Size
+		if target, ok := target(in); ok {
+			leaders[next] = true
+			if starts[target] {
+				leaders[target] = true
+			}
+		}
+		if m := in.Inst.Mnemonic(); m == cpu.HLT || m == cpu.RET || m == cpu.IRET {
+			leaders[next] = true
+		}
+	}
+
+	var (
+		g     Graph
+		block *Block
+	)
+	for _, in := range insts {
+		if block == nil || leaders[in.Offset] {
+			block = &Block{Start: in.Offset}
+			g.Blocks = append(g.Blocks, block)
+		}
+		block.Insts = append(block.Insts, in)
+		block.End = in.Offset + in.Size
+	}
+
+	for _, b := range g.Blocks {
+		b.Succs = successors(b.Insts[len(b.Insts)-1], starts)
+	}
+
+	return &g, nil
+}
+
+func target(in Inst) (int, bool) {
+	jump, ok := in.Inst.Jump()
+	return in.Offset + in.Size + jump, ok
+}
+
+func successors(last Inst, starts map[int]bool) []Edge {
+	var (
+		edges    []Edge
+		next     = last.Offset + last.Size
+		mnemonic = last.Inst.Mnemonic()
+	)
+
+	target, isJump := target(last)
+
+	switch {
+	case mnemonic == cpu.HLT, mnemonic == cpu.RET, mnemonic == cpu.IRET:
+		return nil
+	case !isJump:
+		if starts[next] {
+			edges = append(edges, Edge{To: next, Kind: EdgeFallThrough})
+		}
+		return edges
+	}
+
+	taken, notTaken := conditions(mnemonic)
+	if starts[target] {
+		edges = append(edges, Edge{To: target, Kind: EdgeTaken, Cond: taken})
+	}
+	if mnemonic != cpu.JMP && starts[next] {
+		edges = append(edges, Edge{To: next, Kind: EdgeFallThrough, Cond: notTaken})
+	}
+
+	return edges
+}
+
+// conditions returns when a jump is taken and when it is not. LOOPs
+// decrement cx before the check
+func conditions(m cpu.Mnemonic) (taken, notTaken string) {
+	switch m {
+	case cpu.LOOP:
+		return "cx != 0", "cx == 0"
+	case cpu.LOOPZ:
+		return "cx != 0 && zf", "cx == 0 || !zf"
+	case cpu.LOOPNZ:
+		return "cx != 0 && !zf", "cx == 0 || zf"
+	case cpu.JCXZ:
+		return "cx == 0", "cx != 0"
+	case cpu.JMP:
+		return "", ""
+	default:
+		return m.String(), "not " + m.String()
+	}
+}
+
+// Block returns the block starting at the offset, nil if there is none
+func (g *Graph) Block(start int) *Block {
+	i, ok := slices.BinarySearchFunc(g.Blocks, start, func(b *Block, start int) int { return b.Start - start })
+	if !ok {
+		return nil
+	}
+	return g.Blocks[i]
+}
+
+// WriteDOT writes the graph in Graphviz DOT language
+func (g *Graph) WriteDOT(w io.Writer) error {
+	var b strings.Builder
+
+	b.WriteString("digraph cfg {\n")
+	b.WriteString("\tnode [shape=box fontname=\"monospace\"];\n")
+
+	for _, block := range g.Blocks {
+		var label strings.Builder
+		for _, in := range block.Insts {
+			// NOTE: \l ends a left-justified line of a label
+			fmt.Fprintf(&label, "%04X  %s\\l", in.Offset, strings.ReplaceAll(text(in), `"`, `\"`))
+		}
+		fmt.Fprintf(&b, "\t%s [label=\"%s\"];\n", nodeName(block.Start), label.String())
+	}
+
+	for _, block := range g.Blocks {
+		for _, e := range block.Succs {
+			fmt.Fprintf(&b, "\t%s -> %s", nodeName(block.Start), nodeName(e.To))
+			switch {
+			case e.Cond != "":
+				fmt.Fprintf(&b, " [label=%q]", e.Cond)
+			case e.Kind == EdgeTaken:
+				fmt.Fprintf(&b, " [label=%q]", e.Kind.String())
+			}
+			b.WriteString(";\n")
+		}
+	}
+
+	b.WriteString("}\n")
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func nodeName(start int) string {
+	return fmt.Sprintf("b%04X", start)
+}
+
+// text prints jumps with absolute targets, they are easier to follow in a graph
+func text(in Inst) string {
+	if target, ok := target(in); ok {
+		return fmt.Sprintf("%s %04X", in.Inst.Mnemonic(), target)
+	}
+	return in.Inst.String()
+}
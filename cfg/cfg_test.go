@@ -0,0 +1,124 @@
+package cfg_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cpu8086/asm"
+	"cpu8086/cfg"
+)
+
+const program = `
+org 0x100
+mov cx, 3
+again:
+add ax, 2
+loop again
+jcxz done
+jmp short again
+done:
+cmp ax, 6
+je ok
+hlt
+ok:
+mov bx, ax
+`
+
+func TestBuild(t *testing.T) {
+	stream, err := asm.Assemble(program)
+	require.NoError(t, err)
+
+	g, err := cfg.Build(stream, 0x100)
+	require.NoError(t, err)
+
+	type block struct {
+		start, end int
+		succs      []cfg.Edge
+	}
+	var got []block
+	for _, b := range g.Blocks {
+		got = append(got, block{b.Start, b.End, b.Succs})
+	}
+
+	require.Equal(t, []block{
+		{0x100, 0x103, []cfg.Edge{{To: 0x103, Kind: cfg.EdgeFallThrough}}},
+		{0x103, 0x108, []cfg.Edge{
+			{To: 0x103, Kind: cfg.EdgeTaken, Cond: "cx != 0"},
+			{To: 0x108, Kind: cfg.EdgeFallThrough, Cond: "cx == 0"},
+		}},
+		{0x108, 0x10a, []cfg.Edge{
+			{To: 0x10c, Kind: cfg.EdgeTaken, Cond: "cx == 0"},
+			{To: 0x10a, Kind: cfg.EdgeFallThrough, Cond: "cx != 0"},
+		}},
+		{0x10a, 0x10c, []cfg.Edge{{To: 0x103, Kind: cfg.EdgeTaken}}},
+		{0x10c, 0x111, []cfg.Edge{
+			{To: 0x112, Kind: cfg.EdgeTaken, Cond: "je"},
+			{To: 0x111, Kind: cfg.EdgeFallThrough, Cond: "not je"},
+		}},
+		{0x111, 0x112, nil},
+		{0x112, 0x114, nil},
+	}, got)
+
+	require.Len(t, g.Block(0x10c).Insts, 2)
+	require.Nil(t, g.Block(0x10d))
+}
+
+func TestBuildJumpOutOfStream(t *testing.T) {
+	stream := []byte{
+		0xb9, 0x03, 0x00, // mov cx, 3
+		0xeb, 0x20, // jmp short 0x125
+		0x89, 0xcb, // mov bx, cx
+		0x75, 0xf8, // jne 0x101, the middle of mov cx, 3
+		0xf4, // hlt
+	}
+
+	g, err := cfg.Build(stream, 0x100)
+	require.NoError(t, err)
+
+	var starts []int
+	for _, b := range g.Blocks {
+		starts = append(starts, b.Start)
+	}
+	// NOTE: the code after the jumps starts blocks though the targets aren't
+	// instructions of the stream
+	require.Equal(t, []int{0x100, 0x105, 0x109}, starts)
+	require.Nil(t, g.Block(0x100).Succs)
+	require.Equal(t, []cfg.Edge{{To: 0x109, Kind: cfg.EdgeFallThrough, Cond: "not jne"}}, g.Block(0x105).Succs)
+}
+
+func TestWriteDOT(t *testing.T) {
+	stream, err := asm.Assemble(program)
+	require.NoError(t, err)
+
+	g, err := cfg.Build(stream, 0x100)
+	require.NoError(t, err)
+
+	var b strings.Builder
+	require.NoError(t, g.WriteDOT(&b))
+	require.Equal(t, `digraph cfg {
+	node [shape=box fontname="monospace"];
+	b0100 [label="0100  mov cx, 3\l"];
+	b0103 [label="0103  add ax, 2\l0106  loop 0103\l"];
+	b0108 [label="0108  jcxz 010C\l"];
+	b010A [label="010A  jmp 0103\l"];
+	b010C [label="010C  cmp ax, 6\l010F  je 0112\l"];
+	b0111 [label="0111  hlt\l"];
+	b0112 [label="0112  mov bx, ax\l"];
+	b0100 -> b0103;
+	b0103 -> b0103 [label="cx != 0"];
+	b0103 -> b0108 [label="cx == 0"];
+	b0108 -> b010C [label="cx == 0"];
+	b0108 -> b010A [label="cx != 0"];
+	b010A -> b0103 [label="taken"];
+	b010C -> b0112 [label="je"];
+	b010C -> b0111 [label="not je"];
+}
+`, b.String())
+}
+
+func TestBuildDecodeError(t *testing.T) {
+	_, err := cfg.Build([]byte{0x89, 0xd9, 0x0f}, 0x100)
+	require.EqualError(t, err, "offset 0x102: unknown opcode 0F")
+}
@@ -0,0 +1,255 @@
+package cpu
+
+import (
+	"fmt"
+	"strings"
+)
+
+// CPUModel selects the clock timings: the 8088 has an 8-bit bus, so every
+// word transfer takes an extra bus cycle
+type CPUModel uint8
+
+const (
+	Model8086 CPUModel = iota
+	Model8088
+)
+
+func (m CPUModel) String() string {
+	if m == Model8088 {
+		return "8088"
+	}
+	return "8086"
+}
+
+// Clocks is the timing of an instruction according to the 8086 manual
+type Clocks struct {
+	// Base is the clocks of the instruction form
+	Base int
+	// EA is the effective address calculation time
+	EA int
+	// Penalty is 4 clocks per word transfer at an odd address (8086) or of
+	// any word transfer (8088)
+	Penalty int
+	// Taken is the Base of a jump when it goes to the target. It's set only
+	// for static estimation, when it isn't known
+	Taken int
+
+	// reads and writes are memory transfers of the instruction, a word counts once
+	reads, writes int
+	word          bool
+}
+
+func (c Clocks) Total() int {
+	return c.Base + c.EA + c.Penalty
+}
+
+// String returns the breakdown of clocks like "8 + 6ea + 4p", empty if there
+// is only the base
+func (c Clocks) String() string {
+	if c.EA == 0 && c.Penalty == 0 {
+		return ""
+	}
+
+	s := fmt.Sprint(c.Base)
+	if c.EA != 0 {
+		s += fmt.Sprintf(" + %dea", c.EA)
+	}
+	if c.Penalty != 0 {
+		s += fmt.Sprintf(" + %dp", c.Penalty)
+	}
+	return s
+}
+
+// annotation formats clocks the way the course reference simulator does:
+// "Clocks: +14 = 36 (8 + 6ea)". total is the running total including c
+func (c Clocks) annotation(total int) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Clocks: +%d = %d", c.Total(), total)
+	switch {
+	case c.String() != "":
+		fmt.Fprintf(&b, " (%s)", c)
+	case c.Taken != 0:
+		fmt.Fprintf(&b, " (%d if taken)", c.Taken)
+	}
+	return b.String()
+}
+
+// WithClocks makes Disassemble annotate instructions with clocks and their
+// running total. Jumps are counted as not taken, since that's the order of the
+// listing, and word transfers are at odd addresses only if a direct address is odd
+func WithClocks(model CPUModel) Option {
+	return func(o *options) {
+		o.clocks = true
+		o.model = model
+	}
+}
+
+// eaClocks is the effective address calculation time of the EACFormTable forms
+func eaClocks(o Operand) int {
+	var (
+		e = o.eac
+		// NOTE: BP + DI and BX + SI take a clock less than BP + SI and BX + DI
+		fast = e.reg1 == BP && e.reg2 == DI || e.reg1 == BX && e.reg2 == SI
+	)
+
+	switch {
+	case e.form == 0b000:
+		return 6
+	case e.form == 0b100:
+		return 5
+	case e.form == 0b101:
+		return 9
+	case e.form == 0b110 && fast:
+		return 7
+	case e.form == 0b110:
+		return 8
+	case e.form == 0b111 && fast:
+		return 11
+	default:
+		return 12
+	}
+}
+
+// baseClocks are clocks of the instruction forms: register, memory and
+// immediate operands in the order of dst, src
+type baseClocks struct {
+	regReg, regMem, memReg, regImm, memImm int
+}
+
+var clockTable = map[Mnemonic]baseClocks{
+	MOV: {regReg: 2, regMem: 8, memReg: 9, regImm: 4, memImm: 10},
+	ADD: {regReg: 3, regMem: 9, memReg: 16, regImm: 4, memImm: 17},
+	SUB: {regReg: 3, regMem: 9, memReg: 16, regImm: 4, memImm: 17},
+	CMP: {regReg: 3, regMem: 9, memReg: 9, regImm: 4, memImm: 10},
+}
+
+// jumpClocks are clocks of a jump when it's taken and when it's not
+var jumpClocks = map[Mnemonic][2]int{
+	LOOP:   {17, 5},
+	LOOPZ:  {18, 6},
+	LOOPNZ: {19, 5},
+	JCXZ:   {18, 6},
+	JMP:    {15, 15},
+}
+
+// estimateClocks returns the timing of an instruction. taken tells whether a
+// jump goes to the target, odd — whether a memory operand is at an odd address
+func estimateClocks(inst Instruction, r Rule, model CPUModel, taken, odd bool) Clocks {
+	if r.JMP {
+		t, ok := jumpClocks[inst.mnemonic]
+		if !ok {
+			t = [2]int{16, 4}
+		}
+		if taken {
+			return Clocks{Base: t[0]}
+		}
+		return Clocks{Base: t[1]}
+	}
+
+	switch inst.mnemonic {
+	case HLT:
+		return Clocks{Base: 2}
+	case RET:
+		// NOTE: pops IP
+		c := Clocks{Base: 8, reads: 1, word: true}
+		return c.withPenalty(model, false)
+	case INT:
+		// NOTE: pushes flags, CS and IP and reads the vector
+		c := Clocks{Base: 51, reads: 2, writes: 3, word: true}
+		return c.withPenalty(model, false)
+	case IRET:
+		// NOTE: pops IP, CS and flags
+		c := Clocks{Base: 24, reads: 3, word: true}
+		return c.withPenalty(model, false)
+	case CLI, STI:
+		return Clocks{Base: 2}
+	case IN, OUT:
+		// NOTE: a port transfer is a bus cycle as a memory one is. The fixed port
+		// takes longer, it's fetched with the instruction
+		c := Clocks{Base: 8, word: inst.width() == 16}
+		if r.CheckData != 0 {
+			c.Base = 10
+		}
+		if inst.mnemonic == IN {
+			c.reads = 1
+		} else {
+			c.writes = 1
+		}
+		return c.withPenalty(model, odd)
+	}
+
+	var (
+		c        = Clocks{word: inst.width() == 16}
+		t        = clockTable[inst.mnemonic]
+		dst, src = inst.dst.kind, inst.src.kind
+		// NOTE: a memory destination is read unless it's MOV and written unless it's CMP
+		dstReads  = boolToInt(inst.mnemonic != MOV)
+		dstWrites = boolToInt(inst.mnemonic != CMP)
+	)
+
+	switch {
+	// NOTE: MOV between the accumulator and a direct address has its own short
+	// form, which doesn't spend time on the address
+	case r.DST == operandKindAcc && r.SRC == operandKindDA:
+		c.Base, c.reads = 10, 1
+		return c.withPenalty(model, odd)
+	case r.DST == operandKindDA && r.SRC == operandKindAcc:
+		c.Base, c.writes = 10, 1
+		return c.withPenalty(model, odd)
+	case dst == opKindReg && src == opKindReg:
+		c.Base = t.regReg
+	case dst == opKindReg && src == opKindEAC:
+		c.Base, c.reads = t.regMem, 1
+		c.EA = eaClocks(inst.src)
+	case dst == opKindEAC && src == opKindReg:
+		c.Base, c.reads, c.writes = t.memReg, dstReads, dstWrites
+		c.EA = eaClocks(inst.dst)
+	case dst == opKindReg && src == opKindImm:
+		c.Base = t.regImm
+	case dst == opKindEAC && src == opKindImm:
+		c.Base, c.reads, c.writes = t.memImm, dstReads, dstWrites
+		c.EA = eaClocks(inst.dst)
+	}
+
+	return c.withPenalty(model, odd)
+}
+
+func (c Clocks) withPenalty(model CPUModel, odd bool) Clocks {
+	if c.word && (odd || model == Model8088) {
+		c.Penalty = 4 * (c.reads + c.writes)
+	}
+	return c
+}
+
+// staticClocks estimates clocks of a decoded instruction without running it
+func (d decodedInst) staticClocks(model CPUModel) Clocks {
+	var odd bool
+	for _, o := range [...]Operand{d.inst.dst, d.inst.src} {
+		if o.kind == opKindEAC && o.eac.form == 0b000 && o.eac.dispOrDA%2 != 0 {
+			odd = true
+		}
+	}
+
+	c := estimateClocks(d.inst, d.rule, model, false, odd)
+	if d.rule.JMP && d.inst.mnemonic != JMP {
+		c.Taken = estimateClocks(d.inst, d.rule, model, true, odd).Base
+	}
+	return c
+}
+
+// clockAnnotations returns the clocks comment of every instruction by its offset
+func clockAnnotations(insts []decodedInst, model CPUModel) map[int]string {
+	var (
+		annotations = make(map[int]string, len(insts))
+		total       int
+	)
+	for _, d := range insts {
+		if d.data != dataNone {
+			continue
+		}
+		c := d.staticClocks(model)
+		total += c.Total()
+		annotations[d.offset] = c.annotation(total)
+	}
+	return annotations
+}
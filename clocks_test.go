@@ -0,0 +1,62 @@
+package cpu_test
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	cpu "cpu8086"
+	"cpu8086/asm"
+)
+
+func TestTraceClocks(t *testing.T) {
+	src, err := os.ReadFile("testdata/clocks/estimating_cycles.asm")
+	require.NoError(t, err)
+
+	program, err := asm.Assemble(string(src))
+	require.NoError(t, err)
+
+	for _, model := range []cpu.CPUModel{cpu.Model8086, cpu.Model8088} {
+		t.Run(model.String(), func(t *testing.T) {
+			m := cpu.NewMachine()
+			m.Model = model
+			m.Load(program, 0)
+
+			var b strings.Builder
+			require.NoError(t, m.Trace(&b, 1000, cpu.WithTraceClocks()))
+			got := b.String()
+
+			goldenPath := "testdata/clocks/estimating_cycles." + model.String() + ".txt"
+			if *update {
+				require.NoError(t, os.WriteFile(goldenPath, []byte(got), 0o644))
+			}
+
+			want, err := os.ReadFile(goldenPath)
+			require.NoError(t, err)
+			require.Equal(t, string(want), got)
+		})
+	}
+}
+
+func TestDisassembleClocks(t *testing.T) {
+	program, err := asm.Assemble(`
+mov cx, 2
+again:
+add [1001], cx
+loop again
+jcxz again
+`)
+	require.NoError(t, err)
+
+	out, err := cpu.Disassemble(program, cpu.WithClocks(cpu.Model8086), cpu.WithLabels(nil))
+	require.NoError(t, err)
+	require.Equal(t, `bits 16
+
+mov cx, 2 ; Clocks: +4 = 4
+label_0:
+add [1001], cx ; Clocks: +30 = 34 (16 + 6ea + 8p)
+loop label_0 ; Clocks: +5 = 39 (17 if taken)
+jcxz label_0 ; Clocks: +6 = 45 (18 if taken)`, out)
+}
@@ -0,0 +1,39 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"cpu8086/asm"
+)
+
+// asm assembles the given .asm files into .bin files next to them
+func main() {
+	for _, path := range os.Args[1:] {
+		if err := assemble(path); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+	}
+}
+
+func assemble(path string) error {
+	src, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	program, err := asm.Assemble(string(src))
+	if err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+
+	out := strings.TrimSuffix(path, ".asm") + ".bin"
+	if err := os.WriteFile(out, program, 0o644); err != nil {
+		return err
+	}
+
+	fmt.Printf("Compiled %s -> %s\n", path, out)
+	return nil
+}
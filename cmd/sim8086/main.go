@@ -0,0 +1,571 @@
+// Command sim8086 disassembles and simulates 8086 programs.
+//
+// Usage:
+//
+//	sim8086 disasm [flags] FILE
+//	sim8086 exec [flags] FILE
+//	sim8086 trace [flags] FILE
+//	sim8086 cfg [flags] FILE
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	cpu "cpu8086"
+	"cpu8086/cfg"
+)
+
+const usage = `usage: sim8086 <command> [flags] FILE
+
+commands:
+  disasm  print the disassembly of FILE
+  exec    run FILE until HLT or the end of the code and print final registers
+  trace   run FILE printing every instruction with register and flag changes
+  cfg     print the control-flow graph of FILE in Graphviz DOT
+
+run "sim8086 <command> -h" for the flags of a command
+`
+
+func main() {
+	err := run(os.Args[1:], os.Stdout)
+	if errors.Is(err, flag.ErrHelp) {
+		return
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "sim8086:", err)
+		os.Exit(1)
+	}
+}
+
+var errUsage = errors.New("invalid arguments")
+
+func run(args []string, out io.Writer) error {
+	if len(args) == 0 {
+		fmt.Fprint(os.Stderr, usage)
+		return errUsage
+	}
+
+	switch args[0] {
+	case "disasm":
+		return disasm(args[1:], out)
+	case "exec":
+		return simulate(args[0], args[1:], out, false)
+	case "trace":
+		return simulate(args[0], args[1:], out, true)
+	case "cfg":
+		return graph(args[1:], out)
+	case "-h", "-help", "--help", "help":
+		fmt.Fprint(out, usage)
+		return nil
+	default:
+		fmt.Fprint(os.Stderr, usage)
+		return fmt.Errorf("unknown command: %s", args[0])
+	}
+}
+
+// address is a flag value accepting decimal, 0x-prefixed hex and NASM-like 100h numbers
+type address uint16
+
+func (a *address) String() string { return fmt.Sprintf("0x%x", uint16(*a)) }
+
+func (a *address) Set(s string) error {
+	base, digits := 0, s
+	if h, ok := strings.CutSuffix(strings.ToLower(s), "h"); ok {
+		base, digits = 16, h
+	}
+	v, err := strconv.ParseUint(digits, base, 16)
+	if err != nil {
+		return fmt.Errorf("invalid address: %s", s)
+	}
+	*a = address(v)
+	return nil
+}
+
+// physical is a flag value of a physical address: a number as address accepts or SEGMENT:OFFSET in hex
+type physical uint32
+
+func (p *physical) String() string { return fmt.Sprintf("0x%05x", uint32(*p)) }
+
+func (p *physical) Set(s string) error {
+	if seg, off, ok := strings.Cut(s, ":"); ok {
+		segment, err1 := strconv.ParseUint(seg, 16, 16)
+		offset, err2 := strconv.ParseUint(off, 16, 16)
+		if err1 != nil || err2 != nil {
+			return fmt.Errorf("invalid address: %s", s)
+		}
+		*p = physical((segment<<4 + offset) % cpu.MemorySize)
+		return nil
+	}
+
+	base, digits := 0, s
+	if h, ok := strings.CutSuffix(strings.ToLower(s), "h"); ok {
+		base, digits = 16, h
+	}
+	v, err := strconv.ParseUint(digits, base, 32)
+	if err != nil || v >= cpu.MemorySize {
+		return fmt.Errorf("invalid address: %s", s)
+	}
+	*p = physical(v)
+	return nil
+}
+
+// model is a flag value selecting the CPU model of clock estimation
+type model struct {
+	model cpu.CPUModel
+	set   bool
+}
+
+func (m *model) String() string {
+	if !m.set {
+		return ""
+	}
+	return m.model.String()
+}
+
+func (m *model) Set(s string) error {
+	switch s {
+	case "8086":
+		m.model = cpu.Model8086
+	case "8088":
+		m.model = cpu.Model8088
+	default:
+		return fmt.Errorf("unknown CPU model: %s", s)
+	}
+	m.set = true
+	return nil
+}
+
+func disasm(args []string, out io.Writer) error {
+	var (
+		fs      = flag.NewFlagSet("disasm", flag.ContinueOnError)
+		load    address
+		format  = fs.String("format", "text", "output format: text, listing, json or ndjson")
+		syntax  = fs.String("syntax", "nasm", "assembly syntax: nasm, masm or att")
+		labels  = fs.Bool("labels", false, "print jump targets as labels")
+		symbols = fs.String("symbols", "", "read label names from a symbol `file`")
+		resync  = fs.Bool("resync", false, "print undecodable bytes as data and continue")
+		entries = fs.String("entry", "", "follow the code flow from comma separated `addresses`, the rest is data")
+		nf      cpu.NumberFormat
+		clocks  model
+	)
+	fs.Var(&load, "load", "load `address` of the program")
+	fs.BoolVar(&nf.Hex, "hex", false, "print numbers in hex")
+	fs.BoolVar(&nf.Unsigned, "unsigned", false, "print immediates as unsigned numbers")
+	fs.BoolVar(&nf.Chars, "chars", false, "print printable byte immediates as characters")
+	fs.Var(&clocks, "clocks", "annotate instructions with clocks of a `model`: 8086 or 8088")
+
+	program, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+
+	opts := []cpu.Option{cpu.WithOrigin(int(load)), cpu.WithNumbers(nf)}
+
+	if *resync {
+		opts = append(opts, cpu.WithResync())
+	}
+
+	if clocks.set {
+		opts = append(opts, cpu.WithClocks(clocks.model))
+	}
+
+	if *entries != "" {
+		var points []int
+		for _, s := range strings.Split(*entries, ",") {
+			var entry address
+			if err := entry.Set(strings.TrimSpace(s)); err != nil {
+				return err
+			}
+			points = append(points, int(entry))
+		}
+		opts = append(opts, cpu.WithEntryPoints(points...))
+	}
+
+	switch *format {
+	case "text":
+	case "listing":
+		opts = append(opts, cpu.WithListing())
+	case "json":
+		opts = append(opts, cpu.WithFormat(cpu.FormatJSON))
+	case "ndjson":
+		opts = append(opts, cpu.WithFormat(cpu.FormatNDJSON))
+	default:
+		return fmt.Errorf("unknown format: %s", *format)
+	}
+
+	switch *syntax {
+	case "nasm":
+	case "masm":
+		opts = append(opts, cpu.WithSyntax(cpu.SyntaxMASM))
+	case "att":
+		opts = append(opts, cpu.WithSyntax(cpu.SyntaxATT))
+	default:
+		return fmt.Errorf("unknown syntax: %s", *syntax)
+	}
+
+	switch {
+	case *symbols != "":
+		f, err := os.Open(*symbols)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		syms, err := cpu.ParseSymbols(f)
+		if err != nil {
+			return fmt.Errorf("%s: %w", *symbols, err)
+		}
+		opts = append(opts, cpu.WithLabels(syms))
+	case *labels:
+		opts = append(opts, cpu.WithLabels(nil))
+	}
+
+	var text string
+	if cpu.IsEXE(program) {
+		exe, err := cpu.ParseEXE(program)
+		if err != nil {
+			return err
+		}
+		text, err = cpu.DisassembleEXE(exe, opts...)
+		if err != nil {
+			return err
+		}
+	} else {
+		text, err = cpu.Disassemble(program, opts...)
+		if err != nil {
+			return err
+		}
+	}
+
+	if !strings.HasSuffix(text, "\n") {
+		text += "\n"
+	}
+
+	_, err = io.WriteString(out, text)
+	return err
+}
+
+func simulate(name string, args []string, out io.Writer, trace bool) error {
+	var (
+		fs       = flag.NewFlagSet(name, flag.ContinueOnError)
+		load     address
+		maxSteps = fs.Int("max-steps", 1_000_000, "stop after this many instructions, 0 means no limit")
+		format   = fs.String("format", "text", "output format: text or json")
+		clocks   model
+		com      = fs.Bool("com", false, "load FILE as a DOS .COM program with a PSP, MZ .EXE files are detected")
+		segment  address
+		tail     = fs.String("args", "", "command tail of a DOS program")
+		bus      = fs.Bool("bus", false, "model 8088 bus cycles and the prefetch queue")
+		waits    = fs.Int("wait-states", 0, "wait states of every bus cycle with -bus")
+		root     = fs.String("root", "", "host `directory` for files of a DOS program, none by default")
+		screen   = fs.String("screen", "", "print the text screen after the run: `text` or ansi")
+		pngPath  = fs.String("png", "", "write the text screen after the run to a PNG `file`")
+		fontName = fs.String("font", "8x16", "`font` of the PNG screen: 8x8 of CGA or 8x16 of VGA")
+		mda      = fs.Bool("mda", false, "read the screen from the MDA framebuffer at B000:0000 instead of CGA")
+		dump     = fs.String("dump", "", "write memory after the run to a `file`")
+		dumpAt   physical
+		dumpSize = fs.Int("dump-size", 0x10000, "`bytes` of a raw memory dump")
+		dumpFmt  = fs.String("dump-format", "raw", "`format` of the memory dump: raw, rgba or indexed PNG")
+		width    = fs.Int("dump-width", 0, "width of a PNG memory dump in `pixels`")
+		height   = fs.Int("dump-height", 0, "height of a PNG memory dump in `pixels`")
+		keys     = fs.String("keys", "", "`text` typed on the keyboard of a DOS program, \\n is Enter")
+		wavPath  = fs.String("wav", "", "write the sound of the PC speaker to a WAV `file`")
+		rate     = fs.Int("sample-rate", 44100, "sample `rate` of the WAV file in Hz")
+		scan     = fs.String("scan-codes", "", "keyboard `script` of hex scan codes: CLOCKS:CODE,CODE... entries, CLOCKS is poll for codes which come as the program polls")
+	)
+	fs.Var(&load, "load", "load `address` of the program")
+	fs.Var(&clocks, "clocks", "estimate clocks with timings of a `model`: 8086 or 8088")
+	segment = 0x1000
+	fs.Var(&segment, "segment", "PSP `segment` of a DOS program")
+	fs.Var(&dumpAt, "dump-at", "physical `address` of the memory dump, SEGMENT:OFFSET is accepted")
+
+	program, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	if *format != "text" && *format != "json" {
+		return fmt.Errorf("unknown format: %s", *format)
+	}
+	if *screen != "" && *screen != "text" && *screen != "ansi" {
+		return fmt.Errorf("unknown screen format: %s", *screen)
+	}
+	font, ok := cpu.ParseFont(*fontName)
+	if !ok {
+		return fmt.Errorf("unknown font: %s", *fontName)
+	}
+	pixels, isImage := cpu.ParsePixelFormat(*dumpFmt)
+	if !isImage && *dumpFmt != "raw" {
+		return fmt.Errorf("unknown dump format: %s", *dumpFmt)
+	}
+
+	var (
+		m       = cpu.NewMachine()
+		dos     *cpu.DOS
+		pc      = cpu.NewPC(m)
+		console strings.Builder
+		stdout  = out
+	)
+	// NOTE: the console output of a program goes to the JSON report
+	if *format == "json" {
+		stdout = &console
+	}
+	if err := pc.Keyboard.ParseKeyScript(*scan); err != nil {
+		return err
+	}
+
+	if *com || cpu.IsEXE(program) {
+		dos = &cpu.DOS{Stdout: stdout, Stdin: os.Stdin}
+		if *root != "" {
+			if dos.Root, err = os.OpenRoot(*root); err != nil {
+				return err
+			}
+			defer dos.Root.Close()
+		}
+		defer dos.Close()
+		dos.Install(m)
+
+		bios := &cpu.BIOS{Stdout: stdout, Keys: cpu.KeysOf(strings.ReplaceAll(*keys, `\n`, "\n")), Keyboard: pc.Keyboard}
+		bios.Install(m)
+	}
+
+	switch {
+	case cpu.IsEXE(program):
+		exe, err := cpu.ParseEXE(program)
+		if err != nil {
+			return err
+		}
+		if err := m.LoadEXE(exe, uint16(segment), *tail); err != nil {
+			return err
+		}
+	case *com:
+		if err := m.LoadCOM(program, uint16(segment), *tail); err != nil {
+			return err
+		}
+	default:
+		m.Load(program, uint16(load))
+	}
+	m.Model = clocks.model
+	if *bus {
+		m.Model = cpu.Model8088
+		m.Bus = &cpu.BusTiming{WaitStates: *waits}
+	}
+
+	if trace {
+		var opts []cpu.TraceOption
+		if clocks.set {
+			opts = append(opts, cpu.WithTraceClocks())
+		}
+		if *bus {
+			opts = append(opts, cpu.WithTraceBus())
+		}
+		if *format == "json" {
+			opts = append(opts, cpu.WithTraceJSON())
+		}
+		err = m.Trace(out, *maxSteps, opts...)
+	} else {
+		err = m.Run(*maxSteps)
+	}
+	// NOTE: graphics and sound programs usually loop forever, their run ends
+	// at the limit and leaves the screen and the files as usual
+	stopped := errors.Is(err, cpu.ErrStepLimit)
+	switch {
+	case stopped && *format == "text":
+		_, err = fmt.Fprintf(out, "\nStopped: %s\n", err)
+	case stopped:
+		err = nil
+	}
+	if err != nil {
+		return err
+	}
+
+	adapter := cpu.AdapterCGA
+	if *mda {
+		adapter = cpu.AdapterMDA
+	}
+	display := m.Screen(adapter)
+	final := report{
+		m:       m,
+		stopped: stopped,
+		clocks:  clocks.set || *bus,
+		screen:  *screen,
+		console: console.String(),
+	}
+	if dos != nil {
+		final.exitCode = &dos.ExitCode
+	}
+	if *format == "json" {
+		err = final.writeJSON(out, display)
+	} else {
+		err = final.write(out, display)
+	}
+
+	if err == nil && *pngPath != "" {
+		err = writeFile(*pngPath, func(w io.Writer) error {
+			return display.WritePNG(w, font)
+		})
+	}
+	if err == nil && *dump != "" {
+		err = writeFile(*dump, func(w io.Writer) error {
+			if isImage {
+				return m.WriteMemoryPNG(w, int(dumpAt), *width, *height, pixels)
+			}
+			return m.DumpMemory(w, int(dumpAt), *dumpSize)
+		})
+	}
+	if err == nil && *wavPath != "" {
+		err = writeFile(*wavPath, func(w io.Writer) error {
+			return pc.Speaker.WriteWAV(w, *rate, m.Clocks)
+		})
+	}
+	return err
+}
+
+// report is the state of the machine exec and trace print after the run
+type report struct {
+	m        *cpu.Machine
+	stopped  bool
+	exitCode *uint8
+	// clocks prints the total of clocks and bus clocks if the machine has the bus
+	clocks bool
+	// screen is the format of the screen: text, ansi or none if empty
+	screen string
+	// console is the output of the program in the JSON format
+	console string
+}
+
+// write prints the report as text
+func (r report) write(out io.Writer, screen *cpu.Screen) error {
+	fmt.Fprintln(out, "\nFinal registers:")
+	if err := r.m.DumpRegisters(out); err != nil {
+		return err
+	}
+
+	if r.exitCode != nil && !r.stopped {
+		if _, err := fmt.Fprintf(out, "\nExit code: %d\n", *r.exitCode); err != nil {
+			return err
+		}
+	}
+
+	switch r.screen {
+	case "text":
+		if _, err := fmt.Fprintf(out, "\nScreen:\n%s\n", screen); err != nil {
+			return err
+		}
+	case "ansi":
+		if _, err := fmt.Fprint(out, "\nScreen:\n"); err != nil {
+			return err
+		}
+		if err := screen.WriteANSI(out); err != nil {
+			return err
+		}
+	}
+
+	if r.clocks {
+		if _, err := fmt.Fprintf(out, "\nTotal clocks (%s): %d\n", r.m.Model, r.m.Clocks); err != nil {
+			return err
+		}
+	}
+	if r.clocks && r.m.Bus != nil {
+		if _, err := fmt.Fprintf(out, "Total bus clocks: %d in %d bus cycles\n", r.m.Bus.Clocks, r.m.Bus.BusCycles); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// jsonReport is the schema of the report. Fields are never renamed or
+// removed, new ones could be added.
+type jsonReport struct {
+	cpu.JSONRegisters
+	Stopped  bool   `json:"stopped"`
+	ExitCode *uint8 `json:"exit_code,omitempty"`
+	Console  string `json:"console"`
+	Screen   string `json:"screen,omitempty"`
+	// Clocks are set with -clocks or -bus, bus ones with -bus
+	Clocks    *int `json:"clocks,omitempty"`
+	BusClocks *int `json:"bus_clocks,omitempty"`
+	BusCycles *int `json:"bus_cycles,omitempty"`
+}
+
+// writeJSON prints the report as a JSON object on a line
+func (r report) writeJSON(out io.Writer, screen *cpu.Screen) error {
+	obj := jsonReport{
+		JSONRegisters: r.m.JSONRegisters(),
+		Stopped:       r.stopped,
+		Console:       r.console,
+	}
+	if !r.stopped {
+		obj.ExitCode = r.exitCode
+	}
+
+	switch r.screen {
+	case "text":
+		obj.Screen = screen.String()
+	case "ansi":
+		var b strings.Builder
+		if err := screen.WriteANSI(&b); err != nil {
+			return err
+		}
+		obj.Screen = b.String()
+	}
+
+	if r.clocks {
+		obj.Clocks = &r.m.Clocks
+	}
+	if r.clocks && r.m.Bus != nil {
+		obj.BusClocks = &r.m.Bus.Clocks
+		obj.BusCycles = &r.m.Bus.BusCycles
+	}
+	return json.NewEncoder(out).Encode(obj)
+}
+
+// writeFile creates the file and writes it with the function
+func writeFile(path string, write func(w io.Writer) error) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := write(f); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+func graph(args []string, out io.Writer) error {
+	var (
+		fs   = flag.NewFlagSet("cfg", flag.ContinueOnError)
+		load address
+	)
+	fs.Var(&load, "load", "load `address` of the program")
+
+	program, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+
+	g, err := cfg.Build(program, int(load))
+	if err != nil {
+		return err
+	}
+
+	return g.WriteDOT(out)
+}
+
+func parseArgs(fs *flag.FlagSet, args []string) ([]byte, error) {
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+	if fs.NArg() != 1 {
+		fs.Usage()
+		return nil, errUsage
+	}
+	return os.ReadFile(fs.Arg(0))
+}
@@ -0,0 +1,231 @@
+package main
+
+import (
+	"image/png"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// mov cx, 3; mov bx, cx; hlt
+var program = []byte{0xb9, 0x03, 0x00, 0x89, 0xcb, 0xf4}
+
+func writeProgram(t *testing.T) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "program.bin")
+	require.NoError(t, os.WriteFile(path, program, 0o600))
+	return path
+}
+
+func TestRun(t *testing.T) {
+	path := writeProgram(t)
+
+	tests := []struct {
+		args []string
+		want string
+	}{
+		{
+			args: []string{"disasm", path},
+			want: "bits 16\n\nmov cx, 3\nmov bx, cx\nhlt\n",
+		},
+		{
+			args: []string{"disasm", "-format", "listing", "-load", "100h", path},
+			want: "bits 16\n\n" +
+				"0100  B9 03 00          mov cx, 3\n" +
+				"0103  89 CB             mov bx, cx\n" +
+				"0105  F4                hlt\n",
+		},
+		{
+			args: []string{"exec", path},
+			want: "\nFinal registers:\n" +
+				"      bx: 0x0003 (3)\n" +
+				"      cx: 0x0003 (3)\n" +
+				"      ip: 0x0006 (6)\n",
+		},
+		{
+			args: []string{"trace", "-load", "0x100", path},
+			want: "mov cx, 3 ; cx:0x0->0x3 ip:0x100->0x103 \n" +
+				"mov bx, cx ; bx:0x0->0x3 ip:0x103->0x105 \n" +
+				"hlt ; ip:0x105->0x106 \n" +
+				"\nFinal registers:\n" +
+				"      bx: 0x0003 (3)\n" +
+				"      cx: 0x0003 (3)\n" +
+				"      ip: 0x0106 (262)\n",
+		},
+		{
+			args: []string{"exec", "-format", "json", path},
+			want: `{"registers":{"bx":3,"cx":3},"ip":6,"flags":"","stopped":false,"console":""}` + "\n",
+		},
+		{
+			args: []string{"trace", "-format", "json", "-load", "0x100", "-clocks", "8086", path},
+			want: `{"offset":256,"instruction":"mov cx, 3","registers":{"cx":[0,3]},"ip":[256,259],"flags":["",""],"clocks":4}` + "\n" +
+				`{"offset":259,"instruction":"mov bx, cx","registers":{"bx":[0,3]},"ip":[259,261],"flags":["",""],"clocks":2}` + "\n" +
+				`{"offset":261,"instruction":"hlt","registers":{},"ip":[261,262],"flags":["",""],"clocks":2}` + "\n" +
+				`{"registers":{"bx":3,"cx":3},"ip":262,"flags":"","stopped":false,"console":"","clocks":8}` + "\n",
+		},
+		{
+			args: []string{"cfg", "-load", "0x100", path},
+			want: "digraph cfg {\n" +
+				"\tnode [shape=box fontname=\"monospace\"];\n" +
+				"\tb0100 [label=\"0100  mov cx, 3\\l0103  mov bx, cx\\l0105  hlt\\l\"];\n" +
+				"}\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(strings.Join(tt.args[:len(tt.args)-1], " "), func(t *testing.T) {
+			var out strings.Builder
+			require.NoError(t, run(tt.args, &out))
+			require.Equal(t, tt.want, out.String())
+		})
+	}
+}
+
+func TestRunErrors(t *testing.T) {
+	path := writeProgram(t)
+
+	var out strings.Builder
+	require.ErrorContains(t, run([]string{"run", path}, &out), "unknown command: run")
+	require.ErrorContains(t, run([]string{"disasm", "-format", "xml", path}, &out), "unknown format: xml")
+	require.ErrorContains(t, run([]string{"exec", "-load", "1z", path}, &out), "invalid address: 1z")
+	require.ErrorContains(t, run([]string{"trace", "-format", "listing", path}, &out), "unknown format: listing")
+	require.ErrorIs(t, run([]string{"exec"}, &out), errUsage)
+}
+
+func TestRunDOS(t *testing.T) {
+	// mov ah, 9; mov dx, msg; int 21h; mov ax, 4c02h; int 21h; msg: db 'Hi$'
+	com := []byte{0xb4, 0x09, 0xba, 0x0c, 0x01, 0xcd, 0x21, 0xb8, 0x02, 0x4c, 0xcd, 0x21, 'H', 'i', '$'}
+	path := filepath.Join(t.TempDir(), "hi.com")
+	require.NoError(t, os.WriteFile(path, com, 0o600))
+
+	var out strings.Builder
+	require.NoError(t, run([]string{"exec", "-com", "-root", t.TempDir(), path}, &out))
+	require.True(t, strings.HasPrefix(out.String(), "Hi\nFinal registers:\n"), out.String())
+	require.True(t, strings.HasSuffix(out.String(), "\nExit code: 2\n"), out.String())
+
+	out.Reset()
+	require.NoError(t, run([]string{"exec", "-com", "-format", "json", path}, &out))
+	require.Contains(t, out.String(), `"stopped":false,"exit_code":2,"console":"Hi"}`)
+}
+
+func TestRunBIOS(t *testing.T) {
+	// mov ah, 0; int 16h; mov ah, 0eh; int 10h; ret
+	com := []byte{0xb4, 0x00, 0xcd, 0x16, 0xb4, 0x0e, 0xcd, 0x10, 0xc3}
+	path := filepath.Join(t.TempDir(), "key.com")
+	require.NoError(t, os.WriteFile(path, com, 0o600))
+
+	var out strings.Builder
+	require.NoError(t, run([]string{"exec", "-com", "-keys", "q", path}, &out))
+	require.True(t, strings.HasPrefix(out.String(), "q\nFinal registers:\n"), out.String())
+}
+
+func TestRunTimer(t *testing.T) {
+	// mov al, 30h; out 43h, al; mov al, 10; out 40h, al; mov al, 0; out 40h, al
+	// wait: in al, 20h; cmp al, 1; jne wait; hlt
+	timer := []byte{
+		0xb0, 0x30, 0xe6, 0x43, 0xb0, 0x0a, 0xe6, 0x40, 0xb0, 0x00, 0xe6, 0x40,
+		0xe4, 0x20, 0x3c, 0x01, 0x75, 0xfa, 0xf4,
+	}
+	path := filepath.Join(t.TempDir(), "timer.bin")
+	require.NoError(t, os.WriteFile(path, timer, 0o600))
+
+	var out strings.Builder
+	require.NoError(t, run([]string{"exec", path}, &out))
+	require.Contains(t, out.String(), "      ax: 0x0001 (1)\n")
+}
+
+func TestRunScanCodes(t *testing.T) {
+	// wait: in al, 60h; cmp al, 1; jne wait; hlt
+	poll := []byte{0xe4, 0x60, 0x3c, 0x01, 0x75, 0xfa, 0xf4}
+	path := filepath.Join(t.TempDir(), "poll.bin")
+	require.NoError(t, os.WriteFile(path, poll, 0o600))
+
+	var out strings.Builder
+	require.NoError(t, run([]string{"exec", "-scan-codes", "poll:1e,9e,01", path}, &out))
+	require.Contains(t, out.String(), "      ax: 0x0001 (1)\n")
+
+	require.EqualError(t, run([]string{"exec", "-scan-codes", "poll:1g", path}, &out), "invalid scan code: 1g")
+}
+
+func TestRunWAV(t *testing.T) {
+	path := writeProgram(t)
+	wav := filepath.Join(t.TempDir(), "speaker.wav")
+
+	var out strings.Builder
+	require.NoError(t, run([]string{"exec", "-wav", wav, "-sample-rate", "8000", path}, &out))
+	data, err := os.ReadFile(wav)
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(string(data), "RIFF"))
+	require.Equal(t, "WAVE", string(data[8:12]))
+
+	require.EqualError(t, run([]string{"exec", "-wav", wav, "-sample-rate", "0", path}, &out), "invalid sample rate: 0")
+}
+
+func TestRunStepLimit(t *testing.T) {
+	// mov ah, 0eh; mov al, 'A'; int 10h; jmp $
+	com := []byte{0xb4, 0x0e, 0xb0, 'A', 0xcd, 0x10, 0xeb, 0xfe}
+	dir := t.TempDir()
+	path := filepath.Join(dir, "loop.com")
+	require.NoError(t, os.WriteFile(path, com, 0o600))
+	wav := filepath.Join(dir, "speaker.wav")
+
+	var out strings.Builder
+	require.NoError(t, run([]string{"exec", "-com", "-max-steps", "100", "-screen", "text", "-wav", wav, path}, &out))
+	require.Contains(t, out.String(), "\nStopped: the limit of steps is reached: 100\n")
+	require.True(t, strings.HasSuffix(out.String(), "\nScreen:\nA\n"), out.String())
+	require.NotContains(t, out.String(), "Exit code")
+	require.FileExists(t, wav)
+}
+
+func TestRunScreen(t *testing.T) {
+	// mov ah, 0eh; mov al, 'A'; int 10h; ret
+	com := []byte{0xb4, 0x0e, 0xb0, 'A', 0xcd, 0x10, 0xc3}
+	dir := t.TempDir()
+	path := filepath.Join(dir, "a.com")
+	require.NoError(t, os.WriteFile(path, com, 0o600))
+	pngPath := filepath.Join(dir, "screen.png")
+
+	var out strings.Builder
+	require.NoError(t, run([]string{"exec", "-com", "-screen", "text", "-png", pngPath, path}, &out))
+	require.True(t, strings.HasSuffix(out.String(), "\nExit code: 0\n\nScreen:\nA\n"), out.String())
+
+	data, err := os.ReadFile(pngPath)
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(string(data), "\x89PNG"))
+
+	require.NoError(t, run([]string{"exec", "-com", "-font", "8x8", "-png", pngPath, path}, &out))
+	f, err := os.Open(pngPath)
+	require.NoError(t, err)
+	defer f.Close()
+	config, err := png.DecodeConfig(f)
+	require.NoError(t, err)
+	require.Equal(t, 25*8, config.Height)
+
+	require.ErrorContains(t, run([]string{"exec", "-screen", "html", path}, &out), "unknown screen format: html")
+	require.ErrorContains(t, run([]string{"exec", "-font", "9x14", path}, &out), "unknown font: 9x14")
+}
+
+func TestRunDump(t *testing.T) {
+	path := writeProgram(t)
+	dir := t.TempDir()
+
+	var out strings.Builder
+	raw := filepath.Join(dir, "code.bin")
+	require.NoError(t, run([]string{"exec", "-dump", raw, "-dump-at", "0000:0000", "-dump-size", "6", path}, &out))
+	got, err := os.ReadFile(raw)
+	require.NoError(t, err)
+	require.Equal(t, program, got)
+
+	image := filepath.Join(dir, "code.png")
+	args := []string{"exec", "-dump", image, "-dump-format", "indexed", "-dump-width", "3", "-dump-height", "2", path}
+	require.NoError(t, run(args, &out))
+	got, err = os.ReadFile(image)
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(string(got), "\x89PNG"))
+
+	require.ErrorContains(t, run([]string{"exec", "-dump", image, "-dump-format", "bmp", path}, &out), "unknown dump format: bmp")
+	require.ErrorContains(t, run([]string{"exec", "-dump-at", "100000h", path}, &out), "invalid address: 100000h")
+}
@@ -9,7 +9,52 @@ import (
 )
 
 var mapStrToMnemonic = map[string]cpu.Mnemonic{
-	"MOV": cpu.MOV,
+	"MOV":    cpu.MOV,
+	"ADD":    cpu.ADD,
+	"SUB":    cpu.SUB,
+	"CMP":    cpu.CMP,
+	"JNZ":    cpu.JNZ,
+	"JE":     cpu.JE,
+	"JL":     cpu.JL,
+	"JLE":    cpu.JLE,
+	"JB":     cpu.JB,
+	"JBE":    cpu.JBE,
+	"JP":     cpu.JP,
+	"JO":     cpu.JO,
+	"JS":     cpu.JS,
+	"JNE":    cpu.JNE,
+	"JNL":    cpu.JNL,
+	"JG":     cpu.JG,
+	"JNB":    cpu.JNB,
+	"JA":     cpu.JA,
+	"JNP":    cpu.JNP,
+	"JNO":    cpu.JNO,
+	"JNS":    cpu.JNS,
+	"LOOP":   cpu.LOOP,
+	"LOOPZ":  cpu.LOOPZ,
+	"LOOPNZ": cpu.LOOPNZ,
+	"JCXZ":   cpu.JCXZ,
+	"HLT":    cpu.HLT,
+	"JMP":    cpu.JMP,
+	"RET":    cpu.RET,
+	"INT":    cpu.INT,
+	"IN":     cpu.IN,
+	"OUT":    cpu.OUT,
+	"CLI":    cpu.CLI,
+	"STI":    cpu.STI,
+	"IRET":   cpu.IRET,
+}
+
+var mapStrToForm = map[string]cpu.Form{
+	"acc,addr": cpu.Form_Acc_Addr,
+	"addr,acc": cpu.Form_Addr_Acc,
+	"acc,port": cpu.Form_Acc_Port,
+	"port,acc": cpu.Form_Port_Acc,
+}
+
+var mapStrToCond = map[string]cpu.Cond{
+	"(w=1)":    cpu.Cond_W_Equals_1,
+	"(s:w=01)": cpu.Cond_SW_Equals_01,
 }
 
 func ParseDecodingRule(raw string) (out cpu.DecodingRule, err error) {
@@ -19,13 +64,25 @@ func ParseDecodingRule(raw string) (out cpu.DecodingRule, err error) {
 		return
 	}
 
-	mnemonic, ok := mapStrToMnemonic[split[0]]
+	// NOTE: the mnemonic could be followed by a form, e.g. "MOV acc,addr"
+	rawMnemonic, rawForm, hasForm := strings.Cut(split[0], " ")
+
+	mnemonic, ok := mapStrToMnemonic[rawMnemonic]
 	if !ok {
-		err = fmt.Errorf("invalid mnemonic: %s\n", split[0])
+		err = fmt.Errorf("invalid mnemonic: %s\n", rawMnemonic)
 		return
 	}
 	out.Mnemonic = mnemonic
 
+	if hasForm {
+		form, ok := mapStrToForm[rawForm]
+		if !ok {
+			err = fmt.Errorf("invalid form: %s", rawForm)
+			return
+		}
+		out.Form = form
+	}
+
 	split = split[1:]
 	for byteIdx, rawByte := range split {
 		rawParts := strings.Split(rawByte, " ")
@@ -76,6 +133,11 @@ func ParseDecodingRule(raw string) (out cpu.DecodingRule, err error) {
 				p.Kind = cpu.PartW
 				p.Mask = 0b1
 				p.Shift = shift
+			case "s":
+				shift -= 1
+				p.Kind = cpu.PartS
+				p.Mask = 0b1
+				p.Shift = shift
 			case "disp-lo":
 				shift -= 8
 				p.Kind = cpu.PartDISP_LO
@@ -86,24 +148,37 @@ func ParseDecodingRule(raw string) (out cpu.DecodingRule, err error) {
 				p.Kind = cpu.PartDISP_HI
 				p.Mask = 0b11111111
 				p.Shift = shift
+			case "addr-lo":
+				shift -= 8
+				p.Kind = cpu.PartADDR_LO
+				p.Mask = 0b11111111
+				p.Shift = shift
+			case "addr-hi":
+				shift -= 8
+				p.Kind = cpu.PartADDR_HI
+				p.Mask = 0b11111111
+				p.Shift = shift
+			case "ip-inc8":
+				shift -= 8
+				p.Kind = cpu.PartIP_INC8
+				p.Mask = 0b11111111
+				p.Shift = shift
 			case "data":
 				shift -= 8
 				p.Kind = cpu.PartDATA
 				p.Mask = 0b11111111
 				p.Shift = shift
 
-				if partIdx+1 < len(rawParts) {
-					partIdx++
+				if rawPartIdx+1 < len(rawParts) {
 					rawPartIdx++
-					rawPart = rawParts[partIdx]
+					rawPart = rawParts[rawPartIdx]
 
-					switch rawPart {
-					case "(w=1)":
-						b.Cond = cpu.Cond_W_Equals_1
-					default:
+					cond, ok := mapStrToCond[rawPart]
+					if !ok {
 						err = fmt.Errorf("unsupported condition for a byte: %s", rawPart)
 						return
 					}
+					b.Cond = cond
 				}
 			default:
 				literal, parseErr := strconv.ParseInt(rawPart, 2, 16)
@@ -134,3 +134,43 @@ func TestDecodingRule(t *testing.T) {
 	require.Equal(t, want[4], got.Bytes[4])
 	require.Equal(t, want[5], got.Bytes[5])
 }
+
+func TestDecodingRuleSignExtension(t *testing.T) {
+	const input = "ADD | 100000 s w | mod 000 rm | disp-lo | disp-hi | data | data (s:w=01)"
+
+	got, err := ParseDecodingRule(input)
+
+	require.NoError(t, err)
+	require.Equal(t, cpu.ADD, got.Mnemonic)
+	require.Equal(t, cpu.Form_Empty, got.Form)
+
+	require.Equal(t, cpu.Part{
+		NotEmpty: true,
+		Kind:     cpu.PartS,
+		Mask:     0b1,
+		Shift:    1,
+		Literal:  -1,
+	}, got.Bytes[0].Parts[1])
+	require.Equal(t, cpu.Part{
+		NotEmpty: true,
+		Kind:     cpu.PartLiteral,
+		Mask:     0b111,
+		Shift:    3,
+		Literal:  0b000,
+	}, got.Bytes[1].Parts[1])
+	require.Equal(t, cpu.Cond_Empty, got.Bytes[4].Cond)
+	require.Equal(t, cpu.Cond_SW_Equals_01, got.Bytes[5].Cond)
+}
+
+func TestDecodingRuleForm(t *testing.T) {
+	const input = "MOV acc,addr | 1010000 w | addr-lo | addr-hi"
+
+	got, err := ParseDecodingRule(input)
+
+	require.NoError(t, err)
+	require.Equal(t, cpu.MOV, got.Mnemonic)
+	require.Equal(t, cpu.Form_Acc_Addr, got.Form)
+	require.Equal(t, cpu.PartADDR_LO, got.Bytes[1].Parts[0].Kind)
+	require.Equal(t, cpu.PartADDR_HI, got.Bytes[2].Parts[0].Kind)
+	require.False(t, got.Bytes[3].NotEmpty)
+}
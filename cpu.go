@@ -2,10 +2,8 @@ package cpu
 
 import (
 	"encoding/binary"
-	"errors"
 	"fmt"
 	"io"
-	"strconv"
 	"strings"
 )
 
@@ -22,6 +20,10 @@ const (
 	PartDISP_HI
 	PartDATA
 	PartLiteral
+	PartS
+	PartADDR_LO
+	PartADDR_HI
+	PartIP_INC8
 )
 
 type Cond uint8
@@ -29,10 +31,23 @@ type Cond uint8
 const (
 	Cond_Empty Cond = iota
 	Cond_W_Equals_1
+	Cond_SW_Equals_01
+)
+
+// Form marks rules whose operands cannot be inferred from the parts alone.
+type Form uint8
+
+const (
+	Form_Empty Form = iota
+	Form_Acc_Addr
+	Form_Addr_Acc
+	Form_Acc_Port
+	Form_Port_Acc
 )
 
 type DecodingRule struct {
 	Mnemonic Mnemonic
+	Form     Form
 	Bytes    [6]ByteDecoding
 }
 
@@ -52,7 +67,7 @@ type Part struct {
 
 type (
 	Mnemonic byte
-	register byte
+	Register byte
 )
 
 // TODO: generate from the table.sim8086
@@ -83,10 +98,19 @@ const (
 	LOOPZ
 	LOOPNZ
 	JCXZ
+	HLT
+	JMP
+	RET
+	INT
+	IN
+	OUT
+	CLI
+	STI
+	IRET
 )
 
 const (
-	registerInvalid register = iota
+	registerInvalid Register = iota
 	AL
 	CL
 	DL
@@ -103,6 +127,10 @@ const (
 	BP
 	SI
 	DI
+	ES
+	CS
+	SS
+	DS
 )
 
 // TODO: generate
@@ -133,6 +161,15 @@ var mnemonicToString = [...]string{
 	LOOPZ:           "loopz",
 	LOOPNZ:          "loopnz",
 	JCXZ:            "jcxz",
+	HLT:             "hlt",
+	JMP:             "jmp",
+	RET:             "ret",
+	INT:             "int",
+	IN:              "in",
+	OUT:             "out",
+	CLI:             "cli",
+	STI:             "sti",
+	IRET:            "iret",
 }
 
 var registerToString = [...]string{
@@ -153,12 +190,36 @@ var registerToString = [...]string{
 	BP:              "bp",
 	SI:              "si",
 	DI:              "di",
+	ES:              "es",
+	CS:              "cs",
+	SS:              "ss",
+	DS:              "ds",
 }
 
-func (r register) String() string { return registerToString[r] }
+func (r Register) String() string { return registerToString[r] }
 func (o Mnemonic) String() string { return mnemonicToString[o] }
 
-var REGTable = [...][2]register{
+// ParseMnemonic looks up a mnemonic by its (lowercase) name
+func ParseMnemonic(s string) (Mnemonic, bool) {
+	for m, str := range mnemonicToString {
+		if Mnemonic(m) != mnemonicInvalid && str == s {
+			return Mnemonic(m), true
+		}
+	}
+	return mnemonicInvalid, false
+}
+
+// ParseRegister looks up a register by its (lowercase) name
+func ParseRegister(s string) (Register, bool) {
+	for r, str := range registerToString {
+		if Register(r) != registerInvalid && str == s {
+			return Register(r), true
+		}
+	}
+	return registerInvalid, false
+}
+
+var REGTable = [...][2]Register{
 	0b000: {AL, AX},
 	0b001: {CL, CX},
 	0b010: {DL, DX},
@@ -169,7 +230,7 @@ var REGTable = [...][2]register{
 	0b111: {BH, DI},
 }
 
-var EACTable = [...][2]register{
+var EACTable = [...][2]Register{
 	0b000: {BX, SI},
 	0b001: {BX, DI},
 	0b010: {BP, SI},
@@ -195,29 +256,149 @@ var EACFormTable = [...][3]uint8{
 	0b111: {0b100, 0b101, 0b101},
 }
 
-func disassemble(stream []byte) (string, error) {
+// Option configures Disassemble
+type Option func(*options)
+
+type options struct {
+	labels   bool
+	symbols  map[int]string
+	listing  bool
+	comments map[int]string
+	format   Format
+	syntax   Syntax
+	numbers  NumberFormat
+	origin   int
+	resync   bool
+	entries  []int
+	clocks   bool
+	model    CPUModel
+}
+
+// WithLabels makes Disassemble print jump targets as labels instead of
+// "$+N" offsets. Names are taken from symbols (offset to name), the rest
+// are named label_0, label_1 and so on
+func WithLabels(symbols map[int]string) Option {
+	return func(o *options) {
+		o.labels = true
+		o.symbols = symbols
+	}
+}
+
+// WithListing makes Disassemble prefix every instruction with its offset
+// and the bytes it was decoded from:
+//
+//	0000  89 D9             mov cx, bx
+func WithListing() Option {
+	return func(o *options) {
+		o.listing = true
+	}
+}
+
+// WithOrigin sets the address the stream is loaded at. Offsets of labels,
+// comments, listings and JSON are counted from it
+func WithOrigin(origin int) Option {
+	return func(o *options) {
+		o.origin = origin
+	}
+}
+
+// WithComments attaches comments (offset to text) to instructions
+func WithComments(comments map[int]string) Option {
+	return func(o *options) {
+		o.comments = comments
+	}
+}
+
+func Disassemble(stream []byte, opts ...Option) (string, error) {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+
 	var (
-		p      = printer{out: &strings.Builder{}}
+		insts  []decodedInst
 		outErr error
 	)
+	switch {
+	case len(o.entries) > 0:
+		insts, outErr = decodeFlow(stream, o.origin, o.entries)
+	case o.resync:
+		insts, outErr = decodeResync(stream, o.origin)
+	default:
+		insts, outErr = decodeAll(stream, o.origin)
+	}
+
+	p := printer{
+		out:      &strings.Builder{},
+		listing:  o.listing,
+		comments: o.comments,
+		syntax:   newDialect(o.syntax, o.numbers),
+	}
+
+	if o.format != FormatText {
+		return printJSON(insts, o.format), outErr
+	}
 
-	p.print("bits 16\n")
+	if o.labels {
+		p.labels = assignLabels(insts, o.origin+len(stream), o.symbols)
+	}
+
+	if o.clocks {
+		p.clocks = clockAnnotations(insts, o.model)
+	}
+
+	p.print("%s\n", p.syntax.header())
+
+	for _, d := range insts {
+		p.printLabel(d.offset)
+		p.printInst(d)
+	}
+	// NOTE: a jump could target the end of the stream
+	p.printLabel(o.origin + len(stream))
+	p.printSkipped(skippedRegions(insts))
+
+	return p.out.String(), outErr
+}
+
+// decodedInst is an instruction along with its place in the stream
+type decodedInst struct {
+	offset int
+	size   int
+	raw    []byte
+	inst   Instruction
+	rule   Rule
+	// data is set when raw bytes are printed as data instead of an instruction
+	data dataKind
+	// bad is set for a byte which couldn't be decoded (resync mode)
+	bad *DecodeError
+}
+
+// decodeAll decodes the stream up to the first error. Offsets start from origin
+func decodeAll(stream []byte, origin int) ([]decodedInst, error) {
+	var insts []decodedInst
 
 	for ip := 0; ip < len(stream); {
 		inst, r, n, err := decode(stream[ip:])
 		if err != nil {
-			outErr = err
-			break
+			return insts, withOffset(err, origin+ip)
 		}
+		insts = append(insts, decodedInst{offset: origin + ip, size: n, raw: stream[ip : ip+n], inst: inst, rule: r})
 		ip += n
-		p.printInst(inst, r)
 	}
 
-	return p.out.String(), outErr
+	return insts, nil
 }
 
 type printer struct {
 	out outWriter
+	// labels are names of offsets. Jumps to these offsets are printed with labels
+	labels map[int]string
+	// listing adds offsets and raw bytes to instructions
+	listing  bool
+	comments map[int]string
+	// clocks are clock annotations of instructions by offset
+	clocks map[int]string
+	syntax dialect
 }
 
 type outWriter interface {
@@ -229,55 +410,98 @@ func (p printer) print(format string, a ...any) {
 	fmt.Fprintf(p.out, format, a...)
 }
 
-func (p printer) printInst(inst instruction, r Rule) {
+func (p printer) printLabel(offset int) {
+	if name, ok := p.labels[offset]; ok {
+		p.print("\n%s:", name)
+	}
+}
+
+func (p printer) printInst(d decodedInst) {
 	p.print("\n")
 
+	if p.listing {
+		// NOTE: an instruction is up to 6 bytes, so the column fits "XX " * 6
+		p.print("%04X  %-18s", d.offset, fmt.Sprintf("% X", d.raw))
+	}
+
 	switch {
-	case r.JMP:
-		const jmpInstSize = 2
-		if inst.jump+jmpInstSize > 0 {
-			p.print("%s $+%d+0", inst.mnemonic, inst.jump+jmpInstSize)
-		} else if inst.jump+jmpInstSize == 0 {
-			p.print("%s $+0", inst.mnemonic)
-		} else {
-			p.print("%s $%d+0", inst.mnemonic, inst.jump+jmpInstSize)
-		}
-	case inst.dst.kind == opKindEAC && inst.src.kind == opKindImm:
-		if inst.src.imm.word && inst.mnemonic == MOV {
-			p.print("%s %s, word %s", inst.mnemonic, inst.dst, inst.src)
-		}
-		if inst.src.imm.word && inst.mnemonic != MOV {
-			p.print("%s word %s, %s", inst.mnemonic, inst.dst, inst.src)
-		}
-		if !inst.src.imm.word && inst.mnemonic == MOV {
-			p.print("%s byte %s, %s", inst.mnemonic, inst.dst, inst.src)
-		}
-		if !inst.src.imm.word && inst.mnemonic != MOV {
-			p.print("%s %s, byte %s", inst.mnemonic, inst.dst, inst.src)
-		}
+	case d.data == dataWords:
+		p.print("%s", p.syntax.dw(d.words()))
+	case d.data == dataBytes:
+		p.print("%s", p.syntax.db(d.raw))
 	default:
-		p.print("%s %s, %s", inst.mnemonic, inst.dst, inst.src)
+		label := p.labels[d.offset+d.size+int(d.inst.jump)]
+		p.print("%s", p.syntax.inst(d.inst, d.rule, label))
+	}
+
+	var comments []string
+	if d.bad != nil {
+		comments = append(comments, d.bad.Reason.String())
+	}
+	if clocks, ok := p.clocks[d.offset]; ok {
+		comments = append(comments, clocks)
+	}
+	if comment, ok := p.comments[d.offset]; ok {
+		comments = append(comments, comment)
+	}
+
+	if len(comments) > 0 {
+		p.print(" %s %s", p.syntax.commentPrefix(), strings.Join(comments, ", "))
 	}
 }
 
-type instruction struct {
+type Instruction struct {
 	mnemonic Mnemonic
 	jump     int8
-	dst      operand
-	src      operand
+	dst      Operand
+	src      Operand
+}
+
+// NewInstruction builds a two operand instruction
+func NewInstruction(mnemonic Mnemonic, dst, src Operand) Instruction {
+	return Instruction{mnemonic: mnemonic, dst: dst, src: src}
+}
+
+// NewJump builds a jump with the offset relative to the next instruction
+func NewJump(mnemonic Mnemonic, jump int8) Instruction {
+	return Instruction{mnemonic: mnemonic, jump: jump}
+}
+
+// String returns the instruction in NASM syntax
+func (inst Instruction) String() string {
+	return nasm{}.inst(inst, Rule{JMP: inst.mnemonic.IsJump()}, "")
+}
+
+// Mnemonic returns the operation of the instruction
+func (inst Instruction) Mnemonic() Mnemonic {
+	return inst.mnemonic
+}
+
+// Jump returns the offset of the jump target relative to the next
+// instruction. ok is false if the instruction isn't a jump
+func (inst Instruction) Jump() (offset int, ok bool) {
+	return int(inst.jump), inst.mnemonic.IsJump()
+}
+
+// IsJump reports whether the mnemonic is a short jump: JMP, a conditional
+// jump or a loop
+//
+// NOTE: conditional jumps and loops go in a row in the list of mnemonics, JMP was added later
+func (o Mnemonic) IsJump() bool {
+	return o >= JNZ && o <= JCXZ || o == JMP
 }
 
-type operand struct {
+type Operand struct {
 	kind operandKind
-	reg  register
+	reg  Register
 	imm  struct {
 		val  int16
 		word bool
 	}
 	eac struct {
 		form     uint8
-		reg1     register
-		reg2     register
+		reg1     Register
+		reg2     Register
 		dispOrDA int16
 	}
 }
@@ -290,20 +514,20 @@ const (
 	opKindEAC
 )
 
-func operandReg(reg register) (o operand) {
+func OperandReg(reg Register) (o Operand) {
 	o.kind = opKindReg
 	o.reg = reg
 	return
 }
 
-func operandImm(val int16, word bool) (o operand) {
+func OperandImm(val int16, word bool) (o Operand) {
 	o.kind = opKindImm
 	o.imm.val = val
 	o.imm.word = word
 	return
 }
 
-func operandEAC(form uint8, disp int16, regs ...register) (o operand) {
+func operandEAC(form uint8, disp int16, regs ...Register) (o Operand) {
 	o.kind = opKindEAC
 	o.eac.form = form
 	o.eac.dispOrDA = disp
@@ -317,34 +541,53 @@ func operandEAC(form uint8, disp int16, regs ...register) (o operand) {
 	return
 }
 
-func (o operand) String() string {
+// OperandMem builds an effective address. The registers should be in
+// the EACTable order (base first); the form is picked from them and disp.
+func OperandMem(disp int16, regs ...Register) Operand {
+	var form uint8
+	switch {
+	case len(regs) == 2 && disp != 0:
+		form = 0b111
+	case len(regs) == 2:
+		form = 0b110
+	case len(regs) == 1 && (disp != 0 || regs[0] == BP):
+		form = 0b101
+	case len(regs) == 1:
+		form = 0b100
+	default:
+		form = 0b000
+	}
+	return operandEAC(form, disp, regs...)
+}
+
+func (o Operand) String() string { return o.format(NumberFormat{}) }
+
+// format prints the operand in NASM syntax
+func (o Operand) format(nf NumberFormat) string {
 	switch o.kind {
 	case opKindReg:
 		return registerToString[o.reg]
 	case opKindImm:
-		return strconv.Itoa(int(o.imm.val))
+		return nf.immediate(o.imm.val, o.imm.word, nasmNumbers)
 	case opKindEAC:
+		var disp string
+		if o.eac.dispOrDA < 0 {
+			disp = " - " + nf.number(-int(o.eac.dispOrDA), nasmNumbers)
+		} else if o.eac.dispOrDA > 0 {
+			disp = " + " + nf.number(int(o.eac.dispOrDA), nasmNumbers)
+		}
+
 		switch o.eac.form {
 		case 0b000:
-			return fmt.Sprintf("[%d]", o.eac.dispOrDA)
+			return fmt.Sprintf("[%s]", nf.address(o.eac.dispOrDA, nasmNumbers))
 		case 0b100:
 			return fmt.Sprintf("[%s]", o.eac.reg1)
 		case 0b110:
 			return fmt.Sprintf("[%s + %s]", o.eac.reg1, o.eac.reg2)
 		case 0b101:
-			if o.eac.dispOrDA < 0 {
-				return fmt.Sprintf("[%s - %d]", o.eac.reg1, -o.eac.dispOrDA)
-			} else if o.eac.dispOrDA > 0 {
-				return fmt.Sprintf("[%s + %d]", o.eac.reg1, o.eac.dispOrDA)
-			}
-			return fmt.Sprintf("[%s]", o.eac.reg1)
+			return fmt.Sprintf("[%s%s]", o.eac.reg1, disp)
 		case 0b111:
-			if o.eac.dispOrDA < 0 {
-				return fmt.Sprintf("[%s + %s - %d]", o.eac.reg1, o.eac.reg2, -o.eac.dispOrDA)
-			} else if o.eac.dispOrDA > 0 {
-				return fmt.Sprintf("[%s + %s + %d]", o.eac.reg1, o.eac.reg2, o.eac.dispOrDA)
-			}
-			return fmt.Sprintf("[%s + %s]", o.eac.reg1, o.eac.reg2)
+			return fmt.Sprintf("[%s + %s%s]", o.eac.reg1, o.eac.reg2, disp)
 		default:
 			panic(fmt.Sprintf("invalid form of EAC: %d", o.eac.form))
 		}
@@ -359,17 +602,36 @@ const (
 	operandKindEac
 	operandKindReg
 	operandKindDA
+	// operandKindPort is the port of IN and OUT: an immediate byte or DX
+	operandKindPort
 )
 
 type Rule struct {
-	CheckData uint8 // 0x00 — no, 0x01 — 8 bits, 0x10 — 16 bits
-	CheckDisp uint8 // 0x00 — no, 0x01 — 8 bits, 0x10 — 16 bits
+	CheckData uint8 // 0b00 — no, 0b01 — 8 bits, 0b10 — 16 bits
+	CheckDisp uint8 // 0b00 — no, 0b01 — 8 bits, 0b10 — 16 bits
 	JMP       bool
 	SRC       int
 	DST       int
 }
 
-func decode(stream []byte) (inst instruction, r Rule, n int, err error) {
+// Decode decodes the instruction at the start of the stream and returns it
+// with its size in bytes. Errors are *DecodeError with the zero offset
+func Decode(stream []byte) (Instruction, int, error) {
+	inst, _, n, err := decode(stream)
+	return inst, n, err
+}
+
+// need returns a truncated instruction error if the stream is shorter than size bytes
+func need(stream []byte, size int) error {
+	if len(stream) < size {
+		err := newDecodeError(ReasonTruncated, stream)
+		err.Needed = size - len(stream)
+		return err
+	}
+	return nil
+}
+
+func decode(stream []byte) (inst Instruction, r Rule, n int, err error) {
 	var (
 		// "Direction" bit. Equals to 0 when src is specified in REG field (and 1 for dst)
 		d = -1
@@ -386,9 +648,19 @@ func decode(stream []byte) (inst instruction, r Rule, n int, err error) {
 	)
 
 	// NOTE: An instruction could from 1 to 6 byte in length
+	if err = need(stream, n+1); err != nil {
+		return
+	}
 	b1 := stream[n]
 	n++
 
+	// NOTE: instructions of the 0x80 group are told apart by REG field of the next byte.
+	// It's read only after the group is matched, so a missing byte is reported as truncation
+	var group byte
+	if len(stream) > n {
+		group = stream[n] >> 3 & 0b111
+	}
+
 	switch {
 	// MOVs
 	case b1>>2 == 0b100010:
@@ -402,6 +674,9 @@ func decode(stream []byte) (inst instruction, r Rule, n int, err error) {
 		w = int(b1 & 0b1)
 
 		// b2
+		if err = need(stream, n+1); err != nil {
+			return
+		}
 		b2 := stream[n]
 		n++
 
@@ -435,6 +710,9 @@ func decode(stream []byte) (inst instruction, r Rule, n int, err error) {
 		w = int(b1 & 0b1)
 
 		// b2
+		if err = need(stream, n+1); err != nil {
+			return
+		}
 		b2 := stream[n]
 		n++
 
@@ -443,6 +721,12 @@ func decode(stream []byte) (inst instruction, r Rule, n int, err error) {
 		mod = int(b2 >> 6)
 		rm = int(b2 & 0b111)
 
+		// NOTE: REG field of this instruction should be 000
+		if b2>>3&0b111 != 0 {
+			err = newDecodeError(ReasonInvalidModRM, stream[:n])
+			return
+		}
+
 		// NOTE: knowledge encoded into this specific instruction: we should read data and put into src
 		if w == 0 {
 			r.CheckData = 0b01
@@ -459,12 +743,9 @@ func decode(stream []byte) (inst instruction, r Rule, n int, err error) {
 		// b1
 		w = int(b1 & 0b1)
 
-		// NOTE: knowledge encoded into this specific instruction: we should read addr into acc
-		if w == 0 {
-			r.CheckData = 0b01
-		} else {
-			r.CheckData = 0b10
-		}
+		// NOTE: knowledge encoded into this specific instruction: we should read addr into acc.
+		// The address is always 16 bits, "w" is the size of the accumulator
+		r.CheckData = 0b10
 	case b1>>1 == 0b1010001:
 		inst.mnemonic = MOV
 
@@ -475,12 +756,9 @@ func decode(stream []byte) (inst instruction, r Rule, n int, err error) {
 		// b1
 		w = int(b1 & 0b1)
 
-		// NOTE: knowledge encoded into this specific instruction: we should read addr into dst
-		if w == 0 {
-			r.CheckData = 0b01
-		} else {
-			r.CheckData = 0b10
-		}
+		// NOTE: knowledge encoded into this specific instruction: we should read addr into dst.
+		// The address is always 16 bits, "w" is the size of the accumulator
+		r.CheckData = 0b10
 
 	// ADDs
 	case b1>>2 == 0:
@@ -491,13 +769,16 @@ func decode(stream []byte) (inst instruction, r Rule, n int, err error) {
 		w = int(b1 & 0b1)
 
 		// b2
+		if err = need(stream, n+1); err != nil {
+			return
+		}
 		b2 := stream[n]
 		n++
 
 		mod = int(b2 >> 6)
 		reg = int(b2 >> 3 & 0b111)
 		rm = int(b2 & 0b111)
-	case b1>>2 == 0b100000 && (stream[n]>>3&0b111) == 0b000:
+	case b1>>2 == 0b100000 && group == 0b000:
 		inst.mnemonic = ADD
 
 		// b1
@@ -505,6 +786,9 @@ func decode(stream []byte) (inst instruction, r Rule, n int, err error) {
 		w = int(b1 & 0b1)
 
 		// b2
+		if err = need(stream, n+1); err != nil {
+			return
+		}
 		b2 := stream[n]
 		n++
 
@@ -543,13 +827,16 @@ func decode(stream []byte) (inst instruction, r Rule, n int, err error) {
 		w = int(b1 & 0b1)
 
 		// b2
+		if err = need(stream, n+1); err != nil {
+			return
+		}
 		b2 := stream[n]
 		n++
 
 		mod = int(b2 >> 6)
 		reg = int(b2 >> 3 & 0b111)
 		rm = int(b2 & 0b111)
-	case b1>>2 == 0b100000 && (stream[n]>>3&0b111) == 0b101:
+	case b1>>2 == 0b100000 && group == 0b101:
 		inst.mnemonic = SUB
 
 		// b1
@@ -557,6 +844,9 @@ func decode(stream []byte) (inst instruction, r Rule, n int, err error) {
 		w = int(b1 & 0b1)
 
 		// b2
+		if err = need(stream, n+1); err != nil {
+			return
+		}
 		b2 := stream[n]
 		n++
 
@@ -598,13 +888,16 @@ func decode(stream []byte) (inst instruction, r Rule, n int, err error) {
 		w = int(b1 & 0b1)
 
 		// b2
+		if err = need(stream, n+1); err != nil {
+			return
+		}
 		b2 := stream[n]
 		n++
 
 		mod = int(b2 >> 6)
 		reg = int(b2 >> 3 & 0b111)
 		rm = int(b2 & 0b111)
-	case b1>>2 == 0b100000 && (stream[n]>>3&0b111) == 0b111:
+	case b1>>2 == 0b100000 && group == 0b111:
 		inst.mnemonic = CMP
 
 		// b1
@@ -612,6 +905,9 @@ func decode(stream []byte) (inst instruction, r Rule, n int, err error) {
 		w = int(b1 & 0b1)
 
 		// b2
+		if err = need(stream, n+1); err != nil {
+			return
+		}
 		b2 := stream[n]
 		n++
 
@@ -641,6 +937,49 @@ func decode(stream []byte) (inst instruction, r Rule, n int, err error) {
 		r.SRC = operandKindImm
 		r.DST = operandKindAcc
 
+	// HLT
+	case b1 == 0b11110100:
+		inst.mnemonic = HLT
+
+	// RET (only the near one without an immediate)
+	case b1 == 0b11000011:
+		inst.mnemonic = RET
+
+	// INT
+	case b1 == 0b11001101:
+		inst.mnemonic = INT
+
+		// NOTE: knowledge encoded into this specific instruction: the type of the interrupt is in data
+		r.CheckData = 0b01
+		r.DST = operandKindImm
+
+	// IN and OUT
+	case b1&0b11110100 == 0b11100100:
+		inst.mnemonic = IN
+		r.DST = operandKindAcc
+		r.SRC = operandKindPort
+		if b1>>1&0b1 == 1 {
+			inst.mnemonic = OUT
+			r.DST, r.SRC = r.SRC, r.DST
+		}
+
+		// b1
+		w = int(b1 & 0b1)
+
+		// NOTE: knowledge encoded into this specific instruction: the fixed port is in data,
+		// the variable one is in DX
+		if b1>>3&0b1 == 0 {
+			r.CheckData = 0b01
+		}
+
+	// CLI, STI and IRET
+	case b1 == 0b11111010:
+		inst.mnemonic = CLI
+	case b1 == 0b11111011:
+		inst.mnemonic = STI
+	case b1 == 0b11001111:
+		inst.mnemonic = IRET
+
 	// JMPs
 	default:
 		jumps := map[byte]Mnemonic{
@@ -664,6 +1003,7 @@ func decode(stream []byte) (inst instruction, r Rule, n int, err error) {
 			0b11100001: LOOPZ,
 			0b11100000: LOOPNZ,
 			0b11100011: JCXZ,
+			0b11101011: JMP,
 		}
 
 		if mnemonic, ok := jumps[b1]; ok {
@@ -674,12 +1014,12 @@ func decode(stream []byte) (inst instruction, r Rule, n int, err error) {
 			break
 		}
 
-		err = fmt.Errorf("unsupported instruction: %b", b1)
+		err = newDecodeError(ReasonUnknownOpcode, stream[:n])
 		return
 	}
 
 	if s == 1 && w == 0 {
-		err = errors.New("impossible combination s = 1 and w = 0")
+		err = newDecodeError(ReasonReserved, stream[:n])
 		return
 	}
 
@@ -712,9 +1052,15 @@ func decode(stream []byte) (inst instruction, r Rule, n int, err error) {
 	var disp int16
 	switch r.CheckDisp {
 	case 0b01:
+		if err = need(stream, n+1); err != nil {
+			return
+		}
 		disp = int16(int8(stream[n]))
 		n++
 	case 0b10:
+		if err = need(stream, n+2); err != nil {
+			return
+		}
 		disp = int16(binary.LittleEndian.Uint16(stream[n:]))
 		n += 2
 	}
@@ -722,9 +1068,15 @@ func decode(stream []byte) (inst instruction, r Rule, n int, err error) {
 	var data int16
 	switch r.CheckData {
 	case 0b01:
+		if err = need(stream, n+1); err != nil {
+			return
+		}
 		data = int16(int8(stream[n]))
 		n++
 	case 0b10:
+		if err = need(stream, n+2); err != nil {
+			return
+		}
 		data = int16(binary.LittleEndian.Uint16(stream[n:]))
 		n += 2
 	}
@@ -736,8 +1088,8 @@ func decode(stream []byte) (inst instruction, r Rule, n int, err error) {
 
 	switch {
 	case r.DST == operandKindReg && r.SRC == operandKindReg:
-		operand1 := operandReg(REGTable[rm][w])
-		operand2 := operandReg(REGTable[reg][w])
+		operand1 := OperandReg(REGTable[rm][w])
+		operand2 := OperandReg(REGTable[reg][w])
 
 		if d == 0 {
 			inst.dst = operand1
@@ -750,10 +1102,10 @@ func decode(stream []byte) (inst instruction, r Rule, n int, err error) {
 		regs := EACTable[rm]
 
 		inst.dst = operandEAC(eacForm, disp, regs[0], regs[1])
-		inst.src = operandImm(data, w == 1)
+		inst.src = OperandImm(data, w == 1)
 	case (r.DST == operandKindEac && r.SRC == operandKindReg) || (r.DST == operandKindReg && r.SRC == operandKindEac):
 		operand1 := operandEAC(eacForm, disp, EACTable[rm][0], EACTable[rm][1])
-		operand2 := operandReg(REGTable[reg][w])
+		operand2 := OperandReg(REGTable[reg][w])
 
 		if d == 0 {
 			inst.dst = operand1
@@ -765,28 +1117,46 @@ func decode(stream []byte) (inst instruction, r Rule, n int, err error) {
 	case r.DST == operandKindReg && r.SRC == operandKindImm:
 		// HACK: something wrong... but it works
 		if mod == 0b11 {
-			inst.dst = operandReg(REGTable[rm][w])
+			inst.dst = OperandReg(REGTable[rm][w])
 		} else {
-			inst.dst = operandReg(REGTable[reg][w])
+			inst.dst = OperandReg(REGTable[reg][w])
 		}
-		inst.src = operandImm(data, w == 1)
+		inst.src = OperandImm(data, w == 1)
 	case r.DST == operandKindDA && r.SRC == operandKindAcc:
 		inst.dst = operandEAC(eacForm, data)
-		inst.src = operandReg(AX)
+		inst.src = OperandReg(REGTable[0][w])
 	case r.DST == operandKindAcc && r.SRC == operandKindDA:
-		inst.dst = operandReg(AX)
+		inst.dst = OperandReg(REGTable[0][w])
 		inst.src = operandEAC(eacForm, data)
 	case r.DST == operandKindAcc && r.SRC == operandKindImm:
 		if w == 1 {
-			inst.dst = operandReg(AX)
-			inst.src = operandImm(data, true)
+			inst.dst = OperandReg(AX)
+			inst.src = OperandImm(data, true)
 		} else {
-			inst.dst = operandReg(AL)
-			inst.src = operandImm(data, false)
+			inst.dst = OperandReg(AL)
+			inst.src = OperandImm(data, false)
 		}
+	case r.DST == operandKindImm:
+		// NOTE: the type of an interrupt is unsigned
+		inst.dst = OperandImm(int16(uint8(data)), false)
+	case r.DST == operandKindAcc && r.SRC == operandKindPort:
+		inst.dst = OperandReg(REGTable[0][w])
+		inst.src = portOperand(r, data)
+	case r.DST == operandKindPort && r.SRC == operandKindAcc:
+		inst.dst = portOperand(r, data)
+		inst.src = OperandReg(REGTable[0][w])
 	case r.JMP:
 		inst.jump = int8(data)
 	}
 
 	return
 }
+
+// portOperand returns the port of IN and OUT: the data byte or DX
+func portOperand(r Rule, data int16) Operand {
+	if r.CheckData == 0b01 {
+		// NOTE: ports are unsigned
+		return OperandImm(int16(uint8(data)), false)
+	}
+	return OperandReg(DX)
+}
@@ -1,14 +1,16 @@
-package cpu
+package cpu_test
 
 import (
+	"errors"
 	"os"
-	"os/exec"
-	"path"
 	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	cpu "cpu8086"
+	"cpu8086/asm"
 )
 
 func TestDecode(t *testing.T) {
@@ -22,8 +24,6 @@ func TestDecode(t *testing.T) {
 
 	for _, name := range filenames {
 		t.Run(name, func(t *testing.T) {
-			tmpDir := t.TempDir()
-
 			stream, err := os.ReadFile("listings/" + name + ".bin")
 			require.NoError(t, err)
 
@@ -51,26 +51,227 @@ func TestDecode(t *testing.T) {
 			want := strings.Join(filtered, "")
 			want = strings.TrimSpace(want)
 
-			got, err := disassemble(stream)
-			require.NoError(t, err)
-
-			tmpFileAsmPath := path.Join(tmpDir, name+".asm")
-			err = os.WriteFile(tmpFileAsmPath, []byte(got), 0o666)
+			got, err := cpu.Disassemble(stream)
 			require.NoError(t, err)
 
-			cmd := exec.Command("nasm", tmpFileAsmPath)
-			err = cmd.Run()
+			binary, err := asm.Assemble(got)
 			if !assert.NoError(t, err) {
 				require.Equal(t, want, got)
 			}
 
-			tmpFileBinPath := path.Join(tmpDir, name)
-			binary, err := os.ReadFile(tmpFileBinPath)
-
-			require.NoError(t, err)
 			if !assert.Equal(t, stream, binary) {
 				require.Equal(t, want, got)
 			}
 		})
 	}
 }
+
+func TestDisassembleLabels(t *testing.T) {
+	filenames := []string{
+		"0037_single_register_mov",
+		"0041_add_sub_cmp_jnz",
+	}
+
+	for _, name := range filenames {
+		t.Run(name, func(t *testing.T) {
+			stream, err := os.ReadFile("listings/" + name + ".bin")
+			require.NoError(t, err)
+
+			got, err := cpu.Disassemble(stream, cpu.WithLabels(nil))
+			require.NoError(t, err)
+			require.NotContains(t, got, "$")
+
+			binary, err := asm.Assemble(got)
+			require.NoError(t, err, got)
+			require.Equal(t, stream, binary, got)
+		})
+	}
+
+	t.Run("symbols", func(t *testing.T) {
+		// test_label0:
+		// jnz test_label1
+		// jnz test_label0
+		// test_label1:
+		// jcxz $+100
+		stream := []byte{0x75, 0x02, 0x75, 0xfc, 0xe3, 0x62}
+
+		symbols, err := cpu.ParseSymbols(strings.NewReader("; offset name\n0x0 start\n"))
+		require.NoError(t, err)
+
+		got, err := cpu.Disassemble(stream, cpu.WithLabels(symbols))
+		require.NoError(t, err)
+
+		want := "bits 16\n\nstart:\njne label_0\njne start\nlabel_0:\njcxz $+100+0"
+		require.Equal(t, want, got)
+	})
+
+	t.Run("symbols named like labels", func(t *testing.T) {
+		// jnz $+4; jnz $+0; jcxz $+0
+		stream := []byte{0x75, 0x02, 0x75, 0xfe, 0xe3, 0xfe}
+
+		symbols, err := cpu.ParseSymbols(strings.NewReader("0100 label_0\n"))
+		require.NoError(t, err)
+		require.Equal(t, map[int]string{100: "label_0"}, symbols)
+		symbols[4] = "label_1"
+
+		got, err := cpu.Disassemble(stream, cpu.WithLabels(symbols))
+		require.NoError(t, err)
+
+		want := "bits 16\n\njne label_1\nlabel_2:\njne label_2\nlabel_1:\njcxz label_1"
+		require.Equal(t, want, got)
+	})
+}
+
+func TestDisassembleListing(t *testing.T) {
+	// mov cx, bx
+	// mov [bp + di], byte 7
+	// jne $-5+0
+	stream := []byte{0x89, 0xd9, 0xc6, 0x03, 0x07, 0x75, 0xf9}
+
+	got, err := cpu.Disassemble(stream, cpu.WithListing(), cpu.WithComments(map[int]string{2: "store"}))
+	require.NoError(t, err)
+
+	want := "bits 16\n" +
+		"\n0000  89 D9             mov cx, bx" +
+		"\n0002  C6 03 07          mov byte [bp + di], 7 ; store" +
+		"\n0005  75 F9             jne $-5+0"
+	require.Equal(t, want, got)
+}
+
+func TestDisassembleDecodeError(t *testing.T) {
+	tests := []struct {
+		name   string
+		stream []byte
+		reason cpu.DecodeReason
+		bytes  []byte
+		msg    string
+	}{
+		{
+			name:   "unknown opcode",
+			stream: []byte{0x89, 0xd9, 0x0f},
+			reason: cpu.ReasonUnknownOpcode,
+			bytes:  []byte{0x0f},
+			msg:    "offset 0x1A3: unknown opcode 0F",
+		},
+		{
+			name:   "invalid ModRM",
+			stream: []byte{0x89, 0xd9, 0xc6, 0x08, 0x01},
+			reason: cpu.ReasonInvalidModRM,
+			bytes:  []byte{0xc6, 0x08},
+			msg:    "offset 0x1A3: invalid ModRM combination C6 08",
+		},
+		{
+			name:   "reserved",
+			stream: []byte{0x89, 0xd9, 0x82, 0xc0, 0x01},
+			reason: cpu.ReasonReserved,
+			bytes:  []byte{0x82, 0xc0},
+			msg:    "offset 0x1A3: reserved encoding 82 C0",
+		},
+		{
+			name:   "truncated opcode group",
+			stream: []byte{0x89, 0xd9, 0x80},
+			reason: cpu.ReasonTruncated,
+			bytes:  []byte{0x80},
+			msg:    "offset 0x1A3: truncated instruction 80: needs 1 more byte(s)",
+		},
+		{
+			name:   "truncated displacement",
+			stream: []byte{0x89, 0xd9, 0x81, 0x86, 0xe8},
+			reason: cpu.ReasonTruncated,
+			bytes:  []byte{0x81, 0x86, 0xe8},
+			msg:    "offset 0x1A3: truncated instruction 81 86 E8: needs 1 more byte(s)",
+		},
+		{
+			name:   "truncated data",
+			stream: []byte{0x89, 0xd9, 0xb8, 0x01},
+			reason: cpu.ReasonTruncated,
+			bytes:  []byte{0xb8, 0x01},
+			msg:    "offset 0x1A3: truncated instruction B8 01: needs 1 more byte(s)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := cpu.Disassemble(tt.stream, cpu.WithOrigin(0x1a1))
+			require.EqualError(t, err, tt.msg)
+			require.Equal(t, "bits 16\n\nmov cx, bx", out)
+
+			var decodeErr *cpu.DecodeError
+			require.True(t, errors.As(err, &decodeErr))
+			require.Equal(t, 0x1a3, decodeErr.Offset)
+			require.Equal(t, tt.reason, decodeErr.Reason)
+			require.Equal(t, tt.bytes, decodeErr.Bytes)
+
+			// NOTE: the error keeps its bytes when the stream changes
+			for i := range tt.stream {
+				tt.stream[i] = 0
+			}
+			require.Equal(t, tt.bytes, decodeErr.Bytes)
+			require.EqualError(t, err, tt.msg)
+		})
+	}
+}
+
+func TestDisassembleResync(t *testing.T) {
+	// mov cx, bx; two unknown opcodes; mov ax, 1; truncated add
+	stream := []byte{0x89, 0xd9, 0x0f, 0xff, 0xb8, 0x01, 0x00, 0x80}
+
+	out, err := cpu.Disassemble(stream, cpu.WithResync(), cpu.WithComments(map[int]string{3: "data?"}))
+	require.NoError(t, err)
+	require.Equal(t, `bits 16
+
+mov cx, bx
+db 0x0f ; unknown opcode
+db 0xff ; unknown opcode, data?
+mov ax, 1
+db 0x80 ; truncated instruction
+
+; skipped 2 region(s), 3 byte(s):
+;   0x0002-0x0003 (2 byte(s)): unknown opcode
+;   0x0007-0x0007 (1 byte(s)): truncated instruction`, out)
+
+	assembled, err := asm.Assemble(out)
+	require.NoError(t, err)
+	require.Equal(t, stream, assembled)
+}
+
+func TestDisassembleEntryPoints(t *testing.T) {
+	src := `
+org 0x100
+mov cx, 3
+again:
+add ax, [table]
+loop again
+jmp short done
+table:
+dw 0x1234, 0xbeef
+db "Hi!$"
+done:
+hlt
+db 0x0f, 0x01, 0x02
+`
+	stream, err := asm.Assemble(src)
+	require.NoError(t, err)
+
+	out, err := cpu.Disassemble(stream, cpu.WithOrigin(0x100), cpu.WithEntryPoints(0x100), cpu.WithLabels(nil))
+	require.NoError(t, err)
+	require.Equal(t, `bits 16
+
+mov cx, 3
+label_0:
+add ax, [267]
+loop label_0
+jmp label_1
+dw 0x1234, 0xbeef, 0x6948
+dw 0x2421
+label_1:
+hlt
+db 0x0f, 0x01, 0x02`, out)
+
+	assembled, err := asm.Assemble(out)
+	require.NoError(t, err)
+	require.Equal(t, stream, assembled)
+
+	_, err = cpu.Disassemble(stream, cpu.WithOrigin(0x100), cpu.WithEntryPoints(0x10))
+	require.EqualError(t, err, "entry point 0x10 is outside of the stream")
+}
@@ -0,0 +1,73 @@
+package cpu
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// DecodeReason tells why an instruction couldn't be decoded
+type DecodeReason uint8
+
+const (
+	// ReasonUnknownOpcode is an opcode the decoder doesn't support
+	ReasonUnknownOpcode DecodeReason = iota + 1
+	// ReasonTruncated is an instruction cut off by the end of the stream
+	ReasonTruncated
+	// ReasonInvalidModRM is a ModRM byte the opcode doesn't allow
+	ReasonInvalidModRM
+	// ReasonReserved is an encoding the manual doesn't define, e.g. s = 1 and w = 0
+	ReasonReserved
+)
+
+func (r DecodeReason) String() string {
+	switch r {
+	case ReasonUnknownOpcode:
+		return "unknown opcode"
+	case ReasonTruncated:
+		return "truncated instruction"
+	case ReasonInvalidModRM:
+		return "invalid ModRM combination"
+	case ReasonReserved:
+		return "reserved encoding"
+	default:
+		return fmt.Sprintf("DecodeReason(%d)", uint8(r))
+	}
+}
+
+// DecodeError is returned when the stream has an instruction which can't be
+// decoded. Use errors.As to get it from errors of Disassemble and Machine.Step.
+type DecodeError struct {
+	// Offset is where the instruction starts: the origin based offset for
+	// Disassemble, IP for Machine.Step
+	Offset int
+	// Bytes are the bytes of the instruction consumed before the error
+	Bytes  []byte
+	Reason DecodeReason
+	// Needed is the number of bytes missing for ReasonTruncated. It's the least
+	// number: the missing bytes could have required even more
+	Needed int
+}
+
+// Error returns the message like "offset 0x1A3: unknown opcode 0F"
+func (e *DecodeError) Error() string {
+	if e.Reason == ReasonTruncated {
+		return fmt.Sprintf("offset 0x%X: %s % X: needs %d more byte(s)", e.Offset, e.Reason, e.Bytes, e.Needed)
+	}
+	return fmt.Sprintf("offset 0x%X: %s % X", e.Offset, e.Reason, e.Bytes)
+}
+
+// newDecodeError copies the consumed bytes, they could be the memory of a
+// machine which goes on changing
+func newDecodeError(reason DecodeReason, consumed []byte) *DecodeError {
+	return &DecodeError{Reason: reason, Bytes: slices.Clone(consumed)}
+}
+
+// withOffset sets the offset of a DecodeError returned by decode
+func withOffset(err error, offset int) error {
+	var decodeErr *DecodeError
+	if errors.As(err, &decodeErr) {
+		decodeErr.Offset = offset
+	}
+	return err
+}
@@ -0,0 +1,32 @@
+package cpu
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func FuzzDecode(f *testing.F) {
+	listings, err := filepath.Glob("listings/*.bin")
+	require.NoError(f, err)
+	for _, name := range listings {
+		stream, err := os.ReadFile(name)
+		require.NoError(f, err)
+		f.Add(stream)
+	}
+	f.Add([]byte{})
+	f.Add([]byte{0x80})
+	f.Add([]byte{0x81, 0x86, 0xe8})
+
+	f.Fuzz(func(t *testing.T, stream []byte) {
+		_, _, n, err := decode(stream)
+		if err != nil {
+			var decodeErr *DecodeError
+			require.ErrorAs(t, err, &decodeErr)
+			return
+		}
+		require.True(t, n >= 1 && n <= min(len(stream), 6), "decode consumed %d bytes of %d", n, len(stream))
+	})
+}
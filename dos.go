@@ -0,0 +1,63 @@
+package cpu
+
+import "fmt"
+
+const (
+	// pspSize is the size of the Program Segment Prefix. DOS programs are loaded right after it
+	pspSize   = 0x100
+	comOrigin = pspSize
+	// comMaxSize leaves room for the PSP and the initial stack word in a segment
+	comMaxSize = 0x10000 - comOrigin - 2
+	// pspTail is the offset of the command tail: its length, characters and CR
+	pspTail = 0x80
+	// maxTailLen is the longest command tail which fits the PSP
+	maxTailLen = 126
+	// memoryTop is the segment right after the conventional memory
+	memoryTop = 0xA000
+)
+
+// LoadCOM loads a DOS .COM program at segment:0100 and builds its Program
+// Segment Prefix at segment:0000: INT 20h at PSP:0, so RET to it exits, the top
+// of memory at PSP:2, INT 21h with RETF at PSP:50 and the command tail at
+// PSP:80. All segment registers are set to the segment, IP to 0x100 and SP to
+// 0xFFFE, which points to a zero word.
+func (m *Machine) LoadCOM(program []byte, segment uint16, tail string) error {
+	if len(program) > comMaxSize {
+		return fmt.Errorf("a .COM program is too big: %d bytes, at most %d", len(program), comMaxSize)
+	}
+	if len(tail) > maxTailLen {
+		return fmt.Errorf("the command tail is too long: %d characters, at most %d", len(tail), maxTailLen)
+	}
+
+	for _, r := range [...]Register{CS, DS, ES, SS} {
+		m.SetReg(r, segment)
+	}
+
+	m.writePSP(segment, tail)
+	m.Load(program, comOrigin)
+
+	m.SetReg(SP, 0xFFFE)
+	for _, offset := range [...]uint16{0xFFFE, 0xFFFF} {
+		m.Memory[m.physical(SS, offset)] = 0
+	}
+
+	return nil
+}
+
+// writePSP builds the Program Segment Prefix at segment:0000
+func (m *Machine) writePSP(segment uint16, tail string) {
+	psp := make([]byte, pspSize)
+	// INT 20h
+	psp[0x00], psp[0x01] = 0xCD, 0x20
+	psp[0x02], psp[0x03] = byte(memoryTop&0xFF), byte(memoryTop>>8)
+	// INT 21h, RETF
+	psp[0x50], psp[0x51], psp[0x52] = 0xCD, 0x21, 0xCB
+	psp[pspTail] = byte(len(tail))
+	copy(psp[pspTail+1:], tail)
+	psp[pspTail+1+len(tail)] = '\r'
+
+	base := uint32(segment) << 4
+	for i, b := range psp {
+		m.Memory[(base+uint32(i))%MemorySize] = b
+	}
+}
@@ -0,0 +1,50 @@
+package cpu_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	cpu "cpu8086"
+	"cpu8086/asm"
+)
+
+func TestLoadCOM(t *testing.T) {
+	program, err := asm.Assemble(`
+org 0x100
+mov cl, [0x80]
+mov al, [0x81]
+mov bx, [2]
+mov dx, [0]
+`)
+	require.NoError(t, err)
+
+	m := cpu.NewMachine()
+	require.NoError(t, m.LoadCOM(program, 0x2000, " A.TXT"))
+
+	for _, r := range []cpu.Register{cpu.CS, cpu.DS, cpu.ES, cpu.SS} {
+		require.Equal(t, uint16(0x2000), m.Reg(r), "register %s", r)
+	}
+	require.Equal(t, uint16(0xfffe), m.Reg(cpu.SP))
+	require.Equal(t, uint16(0x100), m.IP)
+
+	const psp = 0x20000
+	require.Equal(t, []byte("\x06 A.TXT\r"), m.Memory[psp+0x80:psp+0x88])
+	require.Equal(t, []byte{0xcd, 0x21, 0xcb}, m.Memory[psp+0x50:psp+0x53])
+	require.Equal(t, program, m.Memory[psp+0x100:psp+0x100+len(program)])
+	require.Equal(t, []byte{0, 0}, m.Memory[psp+0xfffe:psp+0x10000])
+
+	require.NoError(t, m.Run(100))
+	require.Equal(t, uint16(6), m.Reg(cpu.CL))
+	require.Equal(t, uint16(' '), m.Reg(cpu.AL))
+	require.Equal(t, uint16(0xa000), m.Reg(cpu.BX))
+	// INT 20h
+	require.Equal(t, uint16(0x20cd), m.Reg(cpu.DX))
+}
+
+func TestLoadCOMErrors(t *testing.T) {
+	m := cpu.NewMachine()
+	require.EqualError(t, m.LoadCOM(make([]byte, 0xff00), 0x1000, ""), "a .COM program is too big: 65280 bytes, at most 65278")
+	require.EqualError(t, m.LoadCOM(nil, 0x1000, strings.Repeat("a", 127)), "the command tail is too long: 127 characters, at most 126")
+}
@@ -0,0 +1,302 @@
+package cpu
+
+import (
+	"fmt"
+)
+
+// Encode turns an instruction back into machine code using the same Rules
+// the table.sim8086 describes. When several rules fit the instruction the
+// shortest encoding wins (on a tie — the rule listed first), which is what
+// NASM does: sign-extended imm8, accumulator and direct address short forms.
+func Encode(inst Instruction) ([]byte, error) {
+	var out []byte
+
+	for i := range Rules {
+		if Rules[i].Mnemonic != inst.mnemonic {
+			continue
+		}
+
+		encoded, ok := encodeRule(&Rules[i], inst)
+		if ok && (out == nil || len(encoded) < len(out)) {
+			out = encoded
+		}
+	}
+
+	if out == nil {
+		return nil, fmt.Errorf("no encoding rule for instruction: %s", inst.mnemonic)
+	}
+
+	return out, nil
+}
+
+// fields are the values of the parts of a rule for a specific instruction
+type fields struct {
+	d, s, w  int
+	mod      int
+	reg      int
+	rm       int
+	disp     int16
+	dispSize int // 0, 1 or 2 bytes
+	data     int16
+	addr     int16
+	ipInc    int8
+}
+
+func encodeRule(rule *DecodingRule, inst Instruction) ([]byte, bool) {
+	var (
+		f        fields
+		dst, src = inst.dst, inst.src
+		ok       bool
+	)
+
+	switch {
+	case rule.has(PartIP_INC8):
+		f.ipInc = inst.jump
+		ok = dst.kind == 0 && src.kind == 0
+	case rule.Form == Form_Acc_Addr:
+		ok = f.setAcc(dst) && src.kind == opKindEAC && src.eac.form == 0b000
+		f.addr = src.eac.dispOrDA
+	case rule.Form == Form_Addr_Acc:
+		ok = f.setAcc(src) && dst.kind == opKindEAC && dst.eac.form == 0b000
+		f.addr = dst.eac.dispOrDA
+	case rule.Form == Form_Acc_Port:
+		ok = f.setAcc(dst) && f.setPort(rule, src)
+	case rule.Form == Form_Port_Acc:
+		ok = f.setAcc(src) && f.setPort(rule, dst)
+	case rule.has(PartMOD) && rule.has(PartREG):
+		// Register/memory to/from register
+		switch {
+		case dst.kind == opKindReg && src.kind == opKindReg:
+			var w int
+			f.d = 0
+			f.mod = 0b11
+			f.rm, f.w, ok = regIndex(dst.reg)
+			if ok {
+				f.reg, w, ok = regIndex(src.reg)
+				ok = ok && w == f.w
+			}
+		case dst.kind == opKindEAC && src.kind == opKindReg:
+			f.d = 0
+			f.reg, f.w, ok = regIndex(src.reg)
+			ok = ok && f.setMemory(dst)
+		case dst.kind == opKindReg && src.kind == opKindEAC:
+			f.d = 1
+			f.reg, f.w, ok = regIndex(dst.reg)
+			ok = ok && f.setMemory(src)
+		}
+	case rule.has(PartMOD) && rule.has(PartDATA):
+		// Immediate to register/memory
+		if src.kind != opKindImm {
+			break
+		}
+		switch dst.kind {
+		case opKindReg:
+			f.mod = 0b11
+			f.rm, f.w, ok = regIndex(dst.reg)
+		case opKindEAC:
+			f.w = boolToInt(src.imm.word)
+			ok = f.setMemory(dst)
+		}
+		f.data = src.imm.val
+		if rule.has(PartS) && f.w == 1 && fitsInt8(f.data) {
+			f.s = 1
+		}
+	case rule.has(PartREG) && rule.has(PartDATA):
+		// Immediate to register
+		if dst.kind == opKindReg && src.kind == opKindImm {
+			f.reg, f.w, ok = regIndex(dst.reg)
+			f.data = src.imm.val
+		}
+	case rule.has(PartDATA) && !rule.has(PartW):
+		// Immediate byte only, e.g. the type of an interrupt
+		ok = dst.kind == opKindImm && !dst.imm.word && src.kind == 0
+		f.data = dst.imm.val
+	case rule.has(PartDATA):
+		// Immediate to accumulator
+		ok = f.setAcc(dst) && src.kind == opKindImm
+		f.data = src.imm.val
+	default:
+		// No operands
+		ok = dst.kind == 0 && src.kind == 0
+	}
+
+	if !ok {
+		return nil, false
+	}
+
+	return rule.emit(f), true
+}
+
+func (rule *DecodingRule) has(kind PartKind) bool {
+	for _, b := range rule.Bytes {
+		for _, p := range b.Parts {
+			if p.NotEmpty && p.Kind == kind {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (rule *DecodingRule) emit(f fields) []byte {
+	out := make([]byte, 0, len(rule.Bytes))
+
+	var dataIdx int
+
+	for _, b := range rule.Bytes {
+		if !b.NotEmpty {
+			break
+		}
+
+		switch b.Cond {
+		case Cond_W_Equals_1:
+			if f.w != 1 {
+				continue
+			}
+		case Cond_SW_Equals_01:
+			if f.s != 0 || f.w != 1 {
+				continue
+			}
+		}
+
+		var (
+			value int
+			skip  bool
+		)
+
+		for _, p := range b.Parts {
+			if !p.NotEmpty {
+				continue
+			}
+
+			var v int
+			switch p.Kind {
+			case PartLiteral:
+				v = int(p.Literal)
+			case PartD:
+				v = f.d
+			case PartS:
+				v = f.s
+			case PartW:
+				v = f.w
+			case PartMOD:
+				v = f.mod
+			case PartREG:
+				v = f.reg
+			case PartRM:
+				v = f.rm
+			case PartDISP_LO:
+				skip = f.dispSize < 1
+				v = int(f.disp)
+			case PartDISP_HI:
+				skip = f.dispSize < 2
+				v = int(f.disp) >> 8
+			case PartDATA:
+				v = int(f.data) >> (8 * dataIdx)
+				dataIdx++
+			case PartADDR_LO:
+				v = int(f.addr)
+			case PartADDR_HI:
+				v = int(f.addr) >> 8
+			case PartIP_INC8:
+				v = int(f.ipInc)
+			}
+
+			value |= (v & p.Mask) << p.Shift
+		}
+
+		if !skip {
+			out = append(out, byte(value))
+		}
+	}
+
+	return out
+}
+
+// setAcc sets the "w" field if the operand is the accumulator
+func (f *fields) setAcc(o Operand) bool {
+	switch {
+	case o.kind == opKindReg && o.reg == AL:
+		f.w = 0
+	case o.kind == opKindReg && o.reg == AX:
+		f.w = 1
+	default:
+		return false
+	}
+	return true
+}
+
+// setPort sets the "data" field to the port of IN and OUT. Rules with data
+// take a byte port, the rest — DX
+func (f *fields) setPort(rule *DecodingRule, o Operand) bool {
+	if !rule.has(PartDATA) {
+		return o.kind == opKindReg && o.reg == DX
+	}
+	f.data = o.imm.val
+	return o.kind == opKindImm && o.imm.val >= 0 && o.imm.val <= 0xFF
+}
+
+// setMemory sets "mod", "rm" and displacement fields. The shortest
+// displacement is chosen, except for [bp] which has no 0b00 form.
+func (f *fields) setMemory(o Operand) bool {
+	if o.kind != opKindEAC {
+		return false
+	}
+
+	f.disp = o.eac.dispOrDA
+
+	if o.eac.form == 0b000 {
+		f.mod = 0b00
+		f.rm = 0b110
+		f.dispSize = 2
+		return true
+	}
+
+	rm := -1
+	for i, regs := range EACTable {
+		if regs[0] == o.eac.reg1 && regs[1] == o.eac.reg2 {
+			rm = i
+		}
+	}
+	if rm == -1 {
+		return false
+	}
+	f.rm = rm
+
+	switch {
+	case f.disp == 0 && rm != 0b110:
+		f.mod = 0b00
+		f.dispSize = 0
+	case fitsInt8(f.disp):
+		f.mod = 0b01
+		f.dispSize = 1
+	default:
+		f.mod = 0b10
+		f.dispSize = 2
+	}
+
+	return true
+}
+
+// regIndex returns an index of the register in REGTable and its "w" bit
+func regIndex(r Register) (idx, w int, ok bool) {
+	for i, regs := range REGTable {
+		for w, reg := range regs {
+			if reg == r {
+				return i, w, true
+			}
+		}
+	}
+	return 0, 0, false
+}
+
+func fitsInt8(v int16) bool {
+	return v >= -128 && v <= 127
+}
+
+func boolToInt(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,103 @@
+package cpu
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncode(t *testing.T) {
+	tests := []struct {
+		name string
+		inst Instruction
+		want []byte
+	}{
+		{
+			name: "mov cx, bx",
+			inst: Instruction{mnemonic: MOV, dst: OperandReg(CX), src: OperandReg(BX)},
+			want: []byte{0x89, 0xd9},
+		},
+		{
+			name: "mov ax, [16]",
+			inst: Instruction{mnemonic: MOV, dst: OperandReg(AX), src: operandEAC(0b000, 16)},
+			want: []byte{0xa1, 0x10, 0x00},
+		},
+		{
+			name: "mov al, [40000]",
+			inst: Instruction{mnemonic: MOV, dst: OperandReg(AL), src: operandEAC(0b000, -25536)},
+			want: []byte{0xa0, 0x40, 0x9c},
+		},
+		{
+			name: "mov [bp], ch",
+			inst: Instruction{mnemonic: MOV, dst: operandEAC(0b101, 0, BP), src: OperandReg(CH)},
+			want: []byte{0x88, 0x6e, 0x00},
+		},
+		{
+			name: "mov cl, 12",
+			inst: Instruction{mnemonic: MOV, dst: OperandReg(CL), src: OperandImm(12, false)},
+			want: []byte{0xb1, 0x0c},
+		},
+		{
+			name: "add word [bp + si + 1000], 29",
+			inst: Instruction{mnemonic: ADD, dst: operandEAC(0b111, 1000, BP, SI), src: OperandImm(29, true)},
+			want: []byte{0x83, 0x82, 0xe8, 0x03, 0x1d},
+		},
+		{
+			name: "add ax, 1000",
+			inst: Instruction{mnemonic: ADD, dst: OperandReg(AX), src: OperandImm(1000, true)},
+			want: []byte{0x05, 0xe8, 0x03},
+		},
+		{
+			name: "add ax, 2",
+			inst: Instruction{mnemonic: ADD, dst: OperandReg(AX), src: OperandImm(2, true)},
+			want: []byte{0x83, 0xc0, 0x02},
+		},
+		{
+			name: "cmp al, -30",
+			inst: Instruction{mnemonic: CMP, dst: OperandReg(AL), src: OperandImm(-30, false)},
+			want: []byte{0x3c, 0xe2},
+		},
+		{
+			name: "jnz $-4+0",
+			inst: Instruction{mnemonic: JNZ, jump: -6},
+			want: []byte{0x75, 0xfa},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Encode(tt.inst)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestEncodeRoundTrip(t *testing.T) {
+	filenames := []string{
+		"0037_single_register_mov",
+		"0038_many_register_mov",
+		"0039_more_movs",
+		"0040_challenge_movs",
+		"0041_add_sub_cmp_jnz",
+	}
+
+	for _, name := range filenames {
+		t.Run(name, func(t *testing.T) {
+			stream, err := os.ReadFile("listings/" + name + ".bin")
+			require.NoError(t, err)
+
+			for ip := 0; ip < len(stream); {
+				inst, _, n, err := decode(stream[ip:])
+				require.NoError(t, err)
+
+				got, err := Encode(inst)
+				require.NoError(t, err)
+				require.Equal(t, stream[ip:ip+n], got, "offset %d", ip)
+
+				ip += n
+			}
+		})
+	}
+}
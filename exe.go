@@ -0,0 +1,185 @@
+package cpu
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// EXEHeader is the fixed part of the MZ header, field order is the one of the file
+type EXEHeader struct {
+	Signature [2]byte
+	// LastPageBytes is the number of used bytes of the last 512-byte page, 0 if it's full
+	LastPageBytes uint16
+	Pages         uint16
+	Relocations   uint16
+	// HeaderParagraphs is the size of the header in 16-byte paragraphs
+	HeaderParagraphs uint16
+	MinAlloc         uint16
+	MaxAlloc         uint16
+	// SS and CS are relative to the load segment
+	SS         uint16
+	SP         uint16
+	Checksum   uint16
+	IP         uint16
+	CS         uint16
+	RelocTable uint16
+	Overlay    uint16
+}
+
+// EXERelocation is a far pointer to a segment word of the load module, which
+// gets the load segment added
+type EXERelocation struct {
+	Offset  uint16
+	Segment uint16
+}
+
+// EXE is a parsed MZ executable
+type EXE struct {
+	Header EXEHeader
+	// Image is the load module: the file without the header
+	Image       []byte
+	Relocations []EXERelocation
+}
+
+// exeHeaderSize is the size of EXEHeader in the file
+const exeHeaderSize = 0x1C
+
+// IsEXE reports whether the data starts with the MZ signature
+func IsEXE(data []byte) bool {
+	return bytes.HasPrefix(data, []byte("MZ")) || bytes.HasPrefix(data, []byte("ZM"))
+}
+
+// ParseEXE validates the MZ header and reads the load module and relocations
+func ParseEXE(data []byte) (*EXE, error) {
+	if len(data) < exeHeaderSize {
+		return nil, fmt.Errorf("an MZ header is %d bytes, the file has %d", exeHeaderSize, len(data))
+	}
+	if !IsEXE(data) {
+		return nil, errors.New("no MZ signature")
+	}
+
+	var e EXE
+	// NOTE: the header is a fixed size struct of the exact size, it can't fail
+	_ = binary.Read(bytes.NewReader(data), binary.LittleEndian, &e.Header)
+	h := e.Header
+
+	fileSize := int(h.Pages) * 512
+	if h.LastPageBytes != 0 {
+		fileSize -= 512 - int(h.LastPageBytes)
+	}
+	headerSize := int(h.HeaderParagraphs) * 16
+
+	switch {
+	case h.Pages == 0:
+		return nil, errors.New("the MZ header has no pages")
+	case h.LastPageBytes >= 512:
+		return nil, fmt.Errorf("the last page has %d bytes, at most 512", h.LastPageBytes)
+	case fileSize > len(data):
+		return nil, fmt.Errorf("the MZ header declares %d bytes, the file has %d", fileSize, len(data))
+	case headerSize < exeHeaderSize || headerSize > fileSize:
+		return nil, fmt.Errorf("invalid header size: %d bytes", headerSize)
+	case int(h.RelocTable)+4*int(h.Relocations) > headerSize:
+		return nil, fmt.Errorf("the relocation table at 0x%X with %d entries is out of the header", h.RelocTable, h.Relocations)
+	}
+
+	e.Image = data[headerSize:fileSize]
+
+	for i := range int(h.Relocations) {
+		entry := data[int(h.RelocTable)+4*i:]
+		r := EXERelocation{
+			Offset:  binary.LittleEndian.Uint16(entry),
+			Segment: binary.LittleEndian.Uint16(entry[2:]),
+		}
+		if at := int(r.Segment)*16 + int(r.Offset); at+2 > len(e.Image) {
+			return nil, fmt.Errorf("relocation %04X:%04X is out of the load module", r.Segment, r.Offset)
+		}
+		e.Relocations = append(e.Relocations, r)
+	}
+
+	return &e, nil
+}
+
+// Entry returns the offset of the entry point in the load module
+func (e *EXE) Entry() int {
+	return int(e.Header.CS)*16 + int(e.Header.IP)
+}
+
+// Info describes the header, one fact per line
+func (e *EXE) Info() []string {
+	h := e.Header
+	return []string{
+		fmt.Sprintf("MZ executable: %d bytes of the load module, %d relocation(s)", len(e.Image), len(e.Relocations)),
+		fmt.Sprintf("entry point CS:IP %04X:%04X, offset 0x%X", h.CS, h.IP, e.Entry()),
+		fmt.Sprintf("stack SS:SP %04X:%04X", h.SS, h.SP),
+		fmt.Sprintf("extra memory: %d to %d paragraphs", h.MinAlloc, h.MaxAlloc),
+	}
+}
+
+// LoadEXE builds the PSP at pspSegment, loads the program right after it,
+// applies relocations and sets CS:IP and SS:SP from the header. DS and ES
+// point to the PSP, as DOS does.
+func (m *Machine) LoadEXE(e *EXE, pspSegment uint16, tail string) error {
+	if len(tail) > maxTailLen {
+		return fmt.Errorf("the command tail is too long: %d characters, at most %d", len(tail), maxTailLen)
+	}
+
+	loadSegment := pspSegment + pspSize/16
+	base := uint32(loadSegment) << 4
+	if int(base)+len(e.Image) > MemorySize {
+		return fmt.Errorf("the load module of %d bytes doesn't fit the memory at %04X:0000", len(e.Image), loadSegment)
+	}
+
+	m.writePSP(pspSegment, tail)
+	copy(m.Memory[base:], e.Image)
+
+	for _, r := range e.Relocations {
+		at := base + uint32(r.Segment)<<4 + uint32(r.Offset)
+		v := binary.LittleEndian.Uint16(m.Memory[at:])
+		binary.LittleEndian.PutUint16(m.Memory[at:], v+loadSegment)
+	}
+
+	h := e.Header
+	m.SetReg(DS, pspSegment)
+	m.SetReg(ES, pspSegment)
+	m.SetReg(SS, loadSegment+h.SS)
+	m.SetReg(SP, h.SP)
+	m.SetReg(CS, loadSegment+h.CS)
+
+	// NOTE: the code runs up to the end of the load module or of the code
+	// segment, nothing runs if the code segment starts after the module
+	m.start(h.IP, max(min(int(base)+len(e.Image)-int(m.Reg(CS))<<4, 0x10000), 0))
+
+	return nil
+}
+
+// DisassembleEXE prints the header as comments and disassembles the load
+// module following the code from the entry point, unless options select
+// another mode. Offsets are the ones in the load module, plus the origin
+func DisassembleEXE(e *EXE, opts ...Option) (string, error) {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	if len(o.entries) == 0 && !o.resync {
+		opts = append(opts, WithEntryPoints(o.origin+e.Entry()))
+	}
+
+	out, err := Disassemble(e.Image, opts...)
+	if o.format != FormatText {
+		return out, err
+	}
+
+	var b strings.Builder
+	prefix := newDialect(o.syntax, o.numbers).commentPrefix()
+	for _, line := range e.Info() {
+		fmt.Fprintf(&b, "%s %s\n", prefix, line)
+	}
+	b.WriteString("\n")
+	b.WriteString(out)
+
+	return b.String(), err
+}
@@ -0,0 +1,201 @@
+package cpu_test
+
+import (
+	"encoding/binary"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	cpu "cpu8086"
+	"cpu8086/asm"
+)
+
+// buildEXE makes an MZ file of a 2-paragraph header with one relocation at
+// 0001:0001 and the entry point at 0001:0000
+func buildEXE(t *testing.T, image []byte) []byte {
+	t.Helper()
+
+	const headerSize = 32
+	size := headerSize + len(image)
+
+	header := make([]byte, headerSize)
+	for i, v := range []uint16{
+		0x5A4D,               // MZ
+		uint16(size % 512),   // last page bytes
+		uint16(size/512 + 1), // pages
+		1,                    // relocations
+		headerSize / 16,      // header paragraphs
+		0x10,                 // min alloc
+		0xFFFF,               // max alloc
+		0x0002,               // SS
+		0x0100,               // SP
+		0,                    // checksum
+		0x0000,               // IP
+		0x0001,               // CS
+		0x1C,                 // relocation table
+		0,                    // overlay
+		0x0001, 0x0001,       // relocation offset, segment
+	} {
+		binary.LittleEndian.PutUint16(header[2*i:], v)
+	}
+
+	return append(header, image...)
+}
+
+const exeSource = `
+db "Hello, world!!!$"
+mov ax, 1
+mov bx, ax
+hlt
+`
+
+func TestParseEXE(t *testing.T) {
+	image, err := asm.Assemble(exeSource)
+	require.NoError(t, err)
+
+	exe, err := cpu.ParseEXE(buildEXE(t, image))
+	require.NoError(t, err)
+	require.Equal(t, image, exe.Image)
+	require.Equal(t, []cpu.EXERelocation{{Offset: 1, Segment: 1}}, exe.Relocations)
+	require.Equal(t, 0x10, exe.Entry())
+	require.Equal(t, uint16(0x100), exe.Header.SP)
+}
+
+func TestLoadEXE(t *testing.T) {
+	image, err := asm.Assemble(exeSource)
+	require.NoError(t, err)
+
+	exe, err := cpu.ParseEXE(buildEXE(t, image))
+	require.NoError(t, err)
+
+	m := cpu.NewMachine()
+	require.NoError(t, m.LoadEXE(exe, 0x1000, " /v"))
+
+	require.Equal(t, uint16(0x1011), m.Reg(cpu.CS))
+	require.Equal(t, uint16(0x1012), m.Reg(cpu.SS))
+	require.Equal(t, uint16(0x100), m.Reg(cpu.SP))
+	require.Equal(t, uint16(0x1000), m.Reg(cpu.DS))
+	require.Equal(t, uint16(0x1000), m.Reg(cpu.ES))
+	require.Equal(t, uint16(0), m.IP)
+	require.Equal(t, []byte("\x03 /v\r"), m.Memory[0x10080:0x10085])
+
+	require.NoError(t, m.Run(100))
+	// NOTE: the immediate is relocated by the load segment
+	require.Equal(t, uint16(0x1011), m.Reg(cpu.BX))
+}
+
+func TestLoadEXECodeSegmentAfterModule(t *testing.T) {
+	image, err := asm.Assemble(exeSource)
+	require.NoError(t, err)
+
+	exe, err := cpu.ParseEXE(buildEXE(t, image))
+	require.NoError(t, err)
+	exe.Header.CS = 0x10
+
+	m := cpu.NewMachine()
+	require.NoError(t, m.LoadEXE(exe, 0x1000, ""))
+	require.False(t, m.Running())
+	require.NoError(t, m.Run(100))
+	require.Equal(t, uint16(0), m.IP)
+}
+
+// TestLoadEXEAfterHalt checks that loading a program undoes Halt, so HLT of
+// the new program waits for interrupts again
+func TestLoadEXEAfterHalt(t *testing.T) {
+	image, err := asm.Assemble(`
+db "Hello, world!!!$"
+mov ax, 1
+sti
+hlt
+mov bx, ax
+`)
+	require.NoError(t, err)
+
+	exe, err := cpu.ParseEXE(buildEXE(t, image))
+	require.NoError(t, err)
+
+	m := cpu.NewMachine()
+	cpu.NewPC(m)
+	m.Halt()
+	require.NoError(t, m.LoadEXE(exe, 0x1000, ""))
+
+	for range 3 {
+		_, err := m.Step()
+		require.NoError(t, err)
+	}
+	require.True(t, m.Running())
+}
+
+func TestParseEXEErrors(t *testing.T) {
+	image, err := asm.Assemble(exeSource)
+	require.NoError(t, err)
+	valid := buildEXE(t, image)
+
+	corrupt := func(offset int, v uint16) []byte {
+		data := append([]byte(nil), valid...)
+		binary.LittleEndian.PutUint16(data[offset:], v)
+		return data
+	}
+
+	tests := []struct {
+		name string
+		data []byte
+		err  string
+	}{
+		{"short", valid[:10], "an MZ header is 28 bytes, the file has 10"},
+		{"signature", corrupt(0, 0x1234), "no MZ signature"},
+		{"truncated", valid[:40], "the MZ header declares 54 bytes, the file has 40"},
+		{"no pages", corrupt(4, 0), "the MZ header has no pages"},
+		{"header size", corrupt(8, 10), "invalid header size: 160 bytes"},
+		{"relocation table", corrupt(6, 2), "the relocation table at 0x1C with 2 entries is out of the header"},
+		{"relocation", corrupt(0x1E, 0x10), "relocation 0010:0001 is out of the load module"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := cpu.ParseEXE(tt.data)
+			require.EqualError(t, err, tt.err)
+		})
+	}
+}
+
+func TestDisassembleEXE(t *testing.T) {
+	image, err := asm.Assemble(exeSource)
+	require.NoError(t, err)
+
+	exe, err := cpu.ParseEXE(buildEXE(t, image))
+	require.NoError(t, err)
+
+	out, err := cpu.DisassembleEXE(exe)
+	require.NoError(t, err)
+	require.Equal(t, `; MZ executable: 22 bytes of the load module, 1 relocation(s)
+; entry point CS:IP 0001:0000, offset 0x10
+; stack SS:SP 0002:0100
+; extra memory: 16 to 65535 paragraphs
+
+bits 16
+
+db 0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x2c
+db 0x20, 0x77, 0x6f, 0x72, 0x6c, 0x64
+db 0x21, 0x21, 0x21, 0x24
+mov ax, 1
+mov bx, ax
+hlt`, out)
+}
+
+func TestDisassembleEXEOrigin(t *testing.T) {
+	image, err := asm.Assemble(exeSource)
+	require.NoError(t, err)
+
+	exe, err := cpu.ParseEXE(buildEXE(t, image))
+	require.NoError(t, err)
+
+	out, err := cpu.DisassembleEXE(exe, cpu.WithOrigin(0x100), cpu.WithListing())
+	require.NoError(t, err)
+	// NOTE: the entry point is 0001:0000 from the origin, the data before it is skipped
+	require.True(t, strings.HasSuffix(out, `
+0110  B8 01 00          mov ax, 1
+0113  89 C3             mov bx, ax
+0115  F4                hlt`), out)
+}
@@ -0,0 +1,142 @@
+package cpu
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// WithEntryPoints switches Disassemble from linear sweep to recursive descent.
+// Decoding starts at the entry points (offsets counted from the origin) and
+// follows jump targets and fall-through until HLT, RET, IRET, JMP or an undecodable
+// instruction. Bytes which aren't reached are printed as db/dw data.
+func WithEntryPoints(entries ...int) Option {
+	return func(o *options) {
+		o.entries = entries
+	}
+}
+
+// dataKind is how bytes that aren't code are printed
+type dataKind uint8
+
+const (
+	dataNone dataKind = iota
+	dataBytes
+	dataWords
+)
+
+// NOTE: a line of data fits the same column in a listing as the longest instruction
+const dataLineSize = 6
+
+// words returns raw bytes as little-endian words
+func (d decodedInst) words() []uint16 {
+	words := make([]uint16, len(d.raw)/2)
+	for i := range words {
+		words[i] = binary.LittleEndian.Uint16(d.raw[2*i:])
+	}
+	return words
+}
+
+// decodeFlow decodes instructions reachable from the entry points, the rest
+// of the stream becomes data
+func decodeFlow(stream []byte, origin int, entries []int) ([]decodedInst, error) {
+	var (
+		// code has decoded instructions by their start in the stream
+		code = make(map[int]decodedInst)
+		// covered marks bytes of decoded instructions
+		covered = make([]bool, len(stream))
+		queue   []int
+	)
+
+	for _, entry := range entries {
+		if entry < origin || entry >= origin+len(stream) {
+			return nil, fmt.Errorf("entry point 0x%X is outside of the stream", entry)
+		}
+		queue = append(queue, entry-origin)
+	}
+
+	for len(queue) > 0 {
+		ip := queue[len(queue)-1]
+		queue = queue[:len(queue)-1]
+
+		// NOTE: an offset in the middle of an instruction isn't decoded again,
+		// overlapping instructions are rare and can't be printed anyway
+		if ip < 0 || ip >= len(stream) || covered[ip] {
+			continue
+		}
+
+		inst, r, n, err := decode(stream[ip:])
+		if err != nil || overlaps(covered[ip:ip+n]) {
+			continue
+		}
+
+		code[ip] = decodedInst{offset: origin + ip, size: n, raw: stream[ip : ip+n], inst: inst, rule: r}
+		for i := ip; i < ip+n; i++ {
+			covered[i] = true
+		}
+
+		switch {
+		case r.JMP && inst.mnemonic == JMP:
+			queue = append(queue, ip+n+int(inst.jump))
+		case r.JMP:
+			queue = append(queue, ip+n+int(inst.jump), ip+n)
+		case inst.mnemonic == HLT, inst.mnemonic == RET, inst.mnemonic == IRET:
+			// NOTE: the flow ends here
+		default:
+			queue = append(queue, ip+n)
+		}
+	}
+
+	var insts []decodedInst
+	for ip := 0; ip < len(stream); {
+		if d, ok := code[ip]; ok {
+			insts = append(insts, d)
+			ip += d.size
+			continue
+		}
+
+		end := ip
+		for end < len(stream) && !covered[end] {
+			end++
+		}
+		insts = append(insts, dataBlock(stream[ip:end], origin+ip)...)
+		ip = end
+	}
+
+	return insts, nil
+}
+
+func overlaps(covered []bool) bool {
+	for _, c := range covered {
+		if c {
+			return true
+		}
+	}
+	return false
+}
+
+// dataBlock splits bytes between instructions into lines of data. Text and
+// odd-sized blocks are bytes, the rest are words, e.g. jump tables
+func dataBlock(raw []byte, offset int) []decodedInst {
+	kind := dataWords
+	if len(raw)%2 != 0 || isText(raw) {
+		kind = dataBytes
+	}
+
+	var lines []decodedInst
+	for start := 0; start < len(raw); start += dataLineSize {
+		end := min(start+dataLineSize, len(raw))
+		lines = append(lines, decodedInst{offset: offset + start, size: end - start, raw: raw[start:end], data: kind})
+	}
+	return lines
+}
+
+// isText reports whether the bytes look like a DOS string: printable
+// characters, line breaks and the "$" terminator
+func isText(raw []byte) bool {
+	for _, b := range raw {
+		if (b < ' ' || b > '~') && b != '\r' && b != '\n' && b != '\t' {
+			return false
+		}
+	}
+	return true
+}
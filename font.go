@@ -0,0 +1,39 @@
+package cpu
+
+// Font is a built-in bitmap font of code page 437 for rendering text screens
+type Font uint8
+
+const (
+	// Font8x8 is the font of CGA
+	Font8x8 Font = iota
+	// Font8x16 is the font of VGA text modes
+	Font8x16
+)
+
+// ParseFont looks up a font by its name: "8x8" or "8x16"
+func ParseFont(s string) (Font, bool) {
+	switch s {
+	case "8x8":
+		return Font8x8, true
+	case "8x16":
+		return Font8x16, true
+	default:
+		return 0, false
+	}
+}
+
+// height returns the height of a character cell in pixels
+func (f Font) height() int {
+	if f == Font8x16 {
+		return 16
+	}
+	return 8
+}
+
+// row returns pixels of a line of a character, the leftmost in the high bit
+func (f Font) row(c byte, y int) byte {
+	if f == Font8x16 {
+		return font8x16[c][y]
+	}
+	return font8x8[c][y]
+}
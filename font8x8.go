@@ -0,0 +1,262 @@
+package cpu
+
+// font8x8 is the character generator of CGA, the same as the graphics font of
+// the PC BIOS
+var font8x8 = [256][8]byte{
+	{0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00}, // 00h
+	{0x7E, 0x81, 0xA5, 0x81, 0xBD, 0x99, 0x81, 0x7E}, // 01h ☺
+	{0x7E, 0xFF, 0xDB, 0xFF, 0xC3, 0xE7, 0xFF, 0x7E}, // 02h ☻
+	{0x6C, 0xFE, 0xFE, 0xFE, 0x7C, 0x38, 0x10, 0x00}, // 03h ♥
+	{0x10, 0x38, 0x7C, 0xFE, 0x7C, 0x38, 0x10, 0x00}, // 04h ♦
+	{0x38, 0x7C, 0x38, 0xFE, 0xFE, 0x7C, 0x38, 0x7C}, // 05h ♣
+	{0x10, 0x10, 0x38, 0x7C, 0xFE, 0x7C, 0x38, 0x7C}, // 06h ♠
+	{0x00, 0x00, 0x18, 0x3C, 0x3C, 0x18, 0x00, 0x00}, // 07h •
+	{0xFF, 0xFF, 0xE7, 0xC3, 0xC3, 0xE7, 0xFF, 0xFF}, // 08h ◘
+	{0x00, 0x3C, 0x66, 0x42, 0x42, 0x66, 0x3C, 0x00}, // 09h ○
+	{0xFF, 0xC3, 0x99, 0xBD, 0xBD, 0x99, 0xC3, 0xFF}, // 0Ah ◙
+	{0x0F, 0x07, 0x0F, 0x7D, 0xCC, 0xCC, 0xCC, 0x78}, // 0Bh ♂
+	{0x3C, 0x66, 0x66, 0x66, 0x3C, 0x18, 0x7E, 0x18}, // 0Ch ♀
+	{0x3F, 0x33, 0x3F, 0x30, 0x30, 0x70, 0xF0, 0xE0}, // 0Dh ♪
+	{0x7F, 0x63, 0x7F, 0x63, 0x63, 0x67, 0xE6, 0xC0}, // 0Eh ♫
+	{0x99, 0x5A, 0x3C, 0xE7, 0xE7, 0x3C, 0x5A, 0x99}, // 0Fh ☼
+	{0x80, 0xE0, 0xF8, 0xFE, 0xF8, 0xE0, 0x80, 0x00}, // 10h ►
+	{0x02, 0x0E, 0x3E, 0xFE, 0x3E, 0x0E, 0x02, 0x00}, // 11h ◄
+	{0x18, 0x3C, 0x7E, 0x18, 0x18, 0x7E, 0x3C, 0x18}, // 12h ↕
+	{0x66, 0x66, 0x66, 0x66, 0x66, 0x00, 0x66, 0x00}, // 13h ‼
+	{0x7F, 0xDB, 0xDB, 0x7B, 0x1B, 0x1B, 0x1B, 0x00}, // 14h ¶
+	{0x3E, 0x63, 0x38, 0x6C, 0x6C, 0x38, 0xCC, 0x78}, // 15h §
+	{0x00, 0x00, 0x00, 0x00, 0x7E, 0x7E, 0x7E, 0x00}, // 16h ▬
+	{0x18, 0x3C, 0x7E, 0x18, 0x7E, 0x3C, 0x18, 0xFF}, // 17h ↨
+	{0x18, 0x3C, 0x7E, 0x18, 0x18, 0x18, 0x18, 0x00}, // 18h ↑
+	{0x18, 0x18, 0x18, 0x18, 0x7E, 0x3C, 0x18, 0x00}, // 19h ↓
+	{0x00, 0x18, 0x0C, 0xFE, 0x0C, 0x18, 0x00, 0x00}, // 1Ah →
+	{0x00, 0x30, 0x60, 0xFE, 0x60, 0x30, 0x00, 0x00}, // 1Bh ←
+	{0x00, 0x00, 0xC0, 0xC0, 0xC0, 0xFE, 0x00, 0x00}, // 1Ch ∟
+	{0x00, 0x24, 0x66, 0xFF, 0x66, 0x24, 0x00, 0x00}, // 1Dh ↔
+	{0x00, 0x18, 0x3C, 0x7E, 0xFF, 0xFF, 0x00, 0x00}, // 1Eh ▲
+	{0x00, 0xFF, 0xFF, 0x7E, 0x3C, 0x18, 0x00, 0x00}, // 1Fh ▼
+	{0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00}, // 20h
+	{0x18, 0x3C, 0x3C, 0x18, 0x18, 0x00, 0x18, 0x00}, // 21h !
+	{0x6C, 0x6C, 0x24, 0x00, 0x00, 0x00, 0x00, 0x00}, // 22h "
+	{0x6C, 0x6C, 0xFE, 0x6C, 0xFE, 0x6C, 0x6C, 0x00}, // 23h #
+	{0x30, 0x7C, 0xC0, 0x78, 0x0C, 0xF8, 0x30, 0x00}, // 24h $
+	{0x00, 0xC6, 0xCC, 0x18, 0x30, 0x66, 0xC6, 0x00}, // 25h %
+	{0x38, 0x6C, 0x38, 0x76, 0xDC, 0xCC, 0x76, 0x00}, // 26h &
+	{0x60, 0x60, 0xC0, 0x00, 0x00, 0x00, 0x00, 0x00}, // 27h '
+	{0x18, 0x30, 0x60, 0x60, 0x60, 0x30, 0x18, 0x00}, // 28h (
+	{0x60, 0x30, 0x18, 0x18, 0x18, 0x30, 0x60, 0x00}, // 29h )
+	{0x00, 0x66, 0x3C, 0xFF, 0x3C, 0x66, 0x00, 0x00}, // 2Ah *
+	{0x00, 0x30, 0x30, 0xFC, 0x30, 0x30, 0x00, 0x00}, // 2Bh +
+	{0x00, 0x00, 0x00, 0x00, 0x00, 0x30, 0x30, 0x60}, // 2Ch ,
+	{0x00, 0x00, 0x00, 0xFC, 0x00, 0x00, 0x00, 0x00}, // 2Dh -
+	{0x00, 0x00, 0x00, 0x00, 0x00, 0x30, 0x30, 0x00}, // 2Eh .
+	{0x06, 0x0C, 0x18, 0x30, 0x60, 0xC0, 0x80, 0x00}, // 2Fh /
+	{0x7C, 0xC6, 0xCE, 0xDE, 0xF6, 0xE6, 0x7C, 0x00}, // 30h 0
+	{0x30, 0x70, 0x30, 0x30, 0x30, 0x30, 0xFC, 0x00}, // 31h 1
+	{0x78, 0xCC, 0x0C, 0x38, 0x60, 0xCC, 0xFC, 0x00}, // 32h 2
+	{0x78, 0xCC, 0x0C, 0x38, 0x0C, 0xCC, 0x78, 0x00}, // 33h 3
+	{0x1C, 0x3C, 0x6C, 0xCC, 0xFE, 0x0C, 0x1E, 0x00}, // 34h 4
+	{0xFC, 0xC0, 0xF8, 0x0C, 0x0C, 0xCC, 0x78, 0x00}, // 35h 5
+	{0x38, 0x60, 0xC0, 0xF8, 0xCC, 0xCC, 0x78, 0x00}, // 36h 6
+	{0xFC, 0xCC, 0x0C, 0x18, 0x30, 0x30, 0x30, 0x00}, // 37h 7
+	{0x78, 0xCC, 0xCC, 0x78, 0xCC, 0xCC, 0x78, 0x00}, // 38h 8
+	{0x78, 0xCC, 0xCC, 0x7C, 0x0C, 0x18, 0x70, 0x00}, // 39h 9
+	{0x00, 0x30, 0x30, 0x00, 0x00, 0x30, 0x30, 0x00}, // 3Ah :
+	{0x00, 0x30, 0x30, 0x00, 0x00, 0x30, 0x30, 0x60}, // 3Bh ;
+	{0x18, 0x30, 0x60, 0xC0, 0x60, 0x30, 0x18, 0x00}, // 3Ch <
+	{0x00, 0x00, 0xFC, 0x00, 0x00, 0xFC, 0x00, 0x00}, // 3Dh =
+	{0x60, 0x30, 0x18, 0x0C, 0x18, 0x30, 0x60, 0x00}, // 3Eh >
+	{0x78, 0xCC, 0x0C, 0x18, 0x30, 0x00, 0x30, 0x00}, // 3Fh ?
+	{0x7C, 0xC6, 0xDE, 0xDE, 0xDE, 0xC0, 0x78, 0x00}, // 40h @
+	{0x30, 0x78, 0xCC, 0xCC, 0xFC, 0xCC, 0xCC, 0x00}, // 41h A
+	{0xFC, 0x66, 0x66, 0x7C, 0x66, 0x66, 0xFC, 0x00}, // 42h B
+	{0x3C, 0x66, 0xC0, 0xC0, 0xC0, 0x66, 0x3C, 0x00}, // 43h C
+	{0xF8, 0x6C, 0x66, 0x66, 0x66, 0x6C, 0xF8, 0x00}, // 44h D
+	{0xFE, 0x62, 0x68, 0x78, 0x68, 0x62, 0xFE, 0x00}, // 45h E
+	{0xFE, 0x62, 0x68, 0x78, 0x68, 0x60, 0xF0, 0x00}, // 46h F
+	{0x3C, 0x66, 0xC0, 0xC0, 0xCE, 0x66, 0x3E, 0x00}, // 47h G
+	{0xCC, 0xCC, 0xCC, 0xFC, 0xCC, 0xCC, 0xCC, 0x00}, // 48h H
+	{0x78, 0x30, 0x30, 0x30, 0x30, 0x30, 0x78, 0x00}, // 49h I
+	{0x1E, 0x0C, 0x0C, 0x0C, 0xCC, 0xCC, 0x78, 0x00}, // 4Ah J
+	{0xE6, 0x66, 0x6C, 0x78, 0x6C, 0x66, 0xE6, 0x00}, // 4Bh K
+	{0xF0, 0x60, 0x60, 0x60, 0x62, 0x66, 0xFE, 0x00}, // 4Ch L
+	{0xC6, 0xEE, 0xFE, 0xFE, 0xD6, 0xC6, 0xC6, 0x00}, // 4Dh M
+	{0xC6, 0xE6, 0xF6, 0xDE, 0xCE, 0xC6, 0xC6, 0x00}, // 4Eh N
+	{0x38, 0x6C, 0xC6, 0xC6, 0xC6, 0x6C, 0x38, 0x00}, // 4Fh O
+	{0xFC, 0x66, 0x66, 0x7C, 0x60, 0x60, 0xF0, 0x00}, // 50h P
+	{0x78, 0xCC, 0xCC, 0xCC, 0xDC, 0x78, 0x1C, 0x00}, // 51h Q
+	{0xFC, 0x66, 0x66, 0x7C, 0x6C, 0x66, 0xE6, 0x00}, // 52h R
+	{0x78, 0xCC, 0xE0, 0x70, 0x1C, 0xCC, 0x78, 0x00}, // 53h S
+	{0xFC, 0xB4, 0x30, 0x30, 0x30, 0x30, 0x78, 0x00}, // 54h T
+	{0xCC, 0xCC, 0xCC, 0xCC, 0xCC, 0xCC, 0xFC, 0x00}, // 55h U
+	{0xCC, 0xCC, 0xCC, 0xCC, 0xCC, 0x78, 0x30, 0x00}, // 56h V
+	{0xC6, 0xC6, 0xC6, 0xD6, 0xFE, 0xEE, 0xC6, 0x00}, // 57h W
+	{0xC6, 0xC6, 0x6C, 0x38, 0x38, 0x6C, 0xC6, 0x00}, // 58h X
+	{0xCC, 0xCC, 0xCC, 0x78, 0x30, 0x30, 0x78, 0x00}, // 59h Y
+	{0xFE, 0xC6, 0x8C, 0x18, 0x32, 0x66, 0xFE, 0x00}, // 5Ah Z
+	{0x78, 0x60, 0x60, 0x60, 0x60, 0x60, 0x78, 0x00}, // 5Bh [
+	{0xC0, 0x60, 0x30, 0x18, 0x0C, 0x06, 0x02, 0x00}, // 5Ch \
+	{0x78, 0x18, 0x18, 0x18, 0x18, 0x18, 0x78, 0x00}, // 5Dh ]
+	{0x10, 0x38, 0x6C, 0xC6, 0x00, 0x00, 0x00, 0x00}, // 5Eh ^
+	{0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xFF}, // 5Fh _
+	{0x30, 0x30, 0x18, 0x00, 0x00, 0x00, 0x00, 0x00}, // 60h `
+	{0x00, 0x00, 0x78, 0x0C, 0x7C, 0xCC, 0x76, 0x00}, // 61h a
+	{0xE0, 0x60, 0x60, 0x7C, 0x66, 0x66, 0xDC, 0x00}, // 62h b
+	{0x00, 0x00, 0x78, 0xCC, 0xC0, 0xCC, 0x78, 0x00}, // 63h c
+	{0x1C, 0x0C, 0x0C, 0x7C, 0xCC, 0xCC, 0x76, 0x00}, // 64h d
+	{0x00, 0x00, 0x78, 0xCC, 0xFC, 0xC0, 0x78, 0x00}, // 65h e
+	{0x38, 0x6C, 0x60, 0xF0, 0x60, 0x60, 0xF0, 0x00}, // 66h f
+	{0x00, 0x00, 0x76, 0xCC, 0xCC, 0x7C, 0x0C, 0xF8}, // 67h g
+	{0xE0, 0x60, 0x6C, 0x76, 0x66, 0x66, 0xE6, 0x00}, // 68h h
+	{0x30, 0x00, 0x70, 0x30, 0x30, 0x30, 0x78, 0x00}, // 69h i
+	{0x0C, 0x00, 0x0C, 0x0C, 0x0C, 0xCC, 0xCC, 0x78}, // 6Ah j
+	{0xE0, 0x60, 0x66, 0x6C, 0x78, 0x6C, 0xE6, 0x00}, // 6Bh k
+	{0x70, 0x30, 0x30, 0x30, 0x30, 0x30, 0x78, 0x00}, // 6Ch l
+	{0x00, 0x00, 0xCC, 0xFE, 0xFE, 0xD6, 0xC6, 0x00}, // 6Dh m
+	{0x00, 0x00, 0xF8, 0xCC, 0xCC, 0xCC, 0xCC, 0x00}, // 6Eh n
+	{0x00, 0x00, 0x78, 0xCC, 0xCC, 0xCC, 0x78, 0x00}, // 6Fh o
+	{0x00, 0x00, 0xDC, 0x66, 0x66, 0x7C, 0x60, 0xF0}, // 70h p
+	{0x00, 0x00, 0x76, 0xCC, 0xCC, 0x7C, 0x0C, 0x1E}, // 71h q
+	{0x00, 0x00, 0xDC, 0x76, 0x66, 0x60, 0xF0, 0x00}, // 72h r
+	{0x00, 0x00, 0x7C, 0xC0, 0x78, 0x0C, 0xF8, 0x00}, // 73h s
+	{0x10, 0x30, 0x7C, 0x30, 0x30, 0x34, 0x18, 0x00}, // 74h t
+	{0x00, 0x00, 0xCC, 0xCC, 0xCC, 0xCC, 0x76, 0x00}, // 75h u
+	{0x00, 0x00, 0xCC, 0xCC, 0xCC, 0x78, 0x30, 0x00}, // 76h v
+	{0x00, 0x00, 0xC6, 0xD6, 0xFE, 0xFE, 0x6C, 0x00}, // 77h w
+	{0x00, 0x00, 0xC6, 0x6C, 0x38, 0x6C, 0xC6, 0x00}, // 78h x
+	{0x00, 0x00, 0xCC, 0xCC, 0xCC, 0x7C, 0x0C, 0xF8}, // 79h y
+	{0x00, 0x00, 0xFC, 0x98, 0x30, 0x64, 0xFC, 0x00}, // 7Ah z
+	{0x1C, 0x30, 0x30, 0xE0, 0x30, 0x30, 0x1C, 0x00}, // 7Bh {
+	{0x18, 0x18, 0x18, 0x00, 0x18, 0x18, 0x18, 0x00}, // 7Ch |
+	{0xE0, 0x30, 0x30, 0x1C, 0x30, 0x30, 0xE0, 0x00}, // 7Dh }
+	{0x76, 0xDC, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00}, // 7Eh ~
+	{0x00, 0x10, 0x38, 0x6C, 0xC6, 0xC6, 0xFE, 0x00}, // 7Fh ⌂
+	{0x78, 0xCC, 0xC0, 0xCC, 0x78, 0x18, 0x0C, 0x78}, // 80h Ç
+	{0x00, 0xCC, 0x00, 0xCC, 0xCC, 0xCC, 0x7E, 0x00}, // 81h ü
+	{0x1C, 0x00, 0x78, 0xCC, 0xFC, 0xC0, 0x78, 0x00}, // 82h é
+	{0x7E, 0xC3, 0x3C, 0x06, 0x3E, 0x66, 0x3F, 0x00}, // 83h â
+	{0xCC, 0x00, 0x78, 0x0C, 0x7C, 0xCC, 0x7E, 0x00}, // 84h ä
+	{0xE0, 0x00, 0x78, 0x0C, 0x7C, 0xCC, 0x7E, 0x00}, // 85h à
+	{0x30, 0x30, 0x78, 0x0C, 0x7C, 0xCC, 0x7E, 0x00}, // 86h å
+	{0x00, 0x00, 0x78, 0xC0, 0xC0, 0x78, 0x0C, 0x38}, // 87h ç
+	{0x7E, 0xC3, 0x3C, 0x66, 0x7E, 0x60, 0x3C, 0x00}, // 88h ê
+	{0xCC, 0x00, 0x78, 0xCC, 0xFC, 0xC0, 0x78, 0x00}, // 89h ë
+	{0xE0, 0x00, 0x78, 0xCC, 0xFC, 0xC0, 0x78, 0x00}, // 8Ah è
+	{0xCC, 0x00, 0x70, 0x30, 0x30, 0x30, 0x78, 0x00}, // 8Bh ï
+	{0x7C, 0xC6, 0x38, 0x18, 0x18, 0x18, 0x3C, 0x00}, // 8Ch î
+	{0xE0, 0x00, 0x70, 0x30, 0x30, 0x30, 0x78, 0x00}, // 8Dh ì
+	{0xC6, 0x38, 0x6C, 0xC6, 0xFE, 0xC6, 0xC6, 0x00}, // 8Eh Ä
+	{0x30, 0x30, 0x00, 0x78, 0xCC, 0xFC, 0xCC, 0x00}, // 8Fh Å
+	{0x1C, 0x00, 0xFC, 0x60, 0x78, 0x60, 0xFC, 0x00}, // 90h É
+	{0x00, 0x00, 0x7F, 0x0C, 0x7F, 0xCC, 0x7F, 0x00}, // 91h æ
+	{0x3E, 0x6C, 0xCC, 0xFE, 0xCC, 0xCC, 0xCE, 0x00}, // 92h Æ
+	{0x78, 0xCC, 0x00, 0x78, 0xCC, 0xCC, 0x78, 0x00}, // 93h ô
+	{0x00, 0xCC, 0x00, 0x78, 0xCC, 0xCC, 0x78, 0x00}, // 94h ö
+	{0x00, 0xE0, 0x00, 0x78, 0xCC, 0xCC, 0x78, 0x00}, // 95h ò
+	{0x78, 0xCC, 0x00, 0xCC, 0xCC, 0xCC, 0x7E, 0x00}, // 96h û
+	{0x00, 0xE0, 0x00, 0xCC, 0xCC, 0xCC, 0x7E, 0x00}, // 97h ù
+	{0x00, 0xCC, 0x00, 0xCC, 0xCC, 0x7C, 0x0C, 0xF8}, // 98h ÿ
+	{0xC3, 0x18, 0x3C, 0x66, 0x66, 0x3C, 0x18, 0x00}, // 99h Ö
+	{0xCC, 0x00, 0xCC, 0xCC, 0xCC, 0xCC, 0x78, 0x00}, // 9Ah Ü
+	{0x18, 0x18, 0x7E, 0xC0, 0xC0, 0x7E, 0x18, 0x18}, // 9Bh ¢
+	{0x38, 0x6C, 0x64, 0xF0, 0x60, 0xE6, 0xFC, 0x00}, // 9Ch £
+	{0xCC, 0xCC, 0x78, 0xFC, 0x30, 0xFC, 0x30, 0x30}, // 9Dh ¥
+	{0xF8, 0xCC, 0xCC, 0xFA, 0xC6, 0xCF, 0xC6, 0xC7}, // 9Eh ₧
+	{0x0E, 0x1B, 0x18, 0x3C, 0x18, 0x18, 0xD8, 0x70}, // 9Fh ƒ
+	{0x1C, 0x00, 0x78, 0x0C, 0x7C, 0xCC, 0x7E, 0x00}, // A0h á
+	{0x38, 0x00, 0x70, 0x30, 0x30, 0x30, 0x78, 0x00}, // A1h í
+	{0x00, 0x1C, 0x00, 0x78, 0xCC, 0xCC, 0x78, 0x00}, // A2h ó
+	{0x00, 0x1C, 0x00, 0xCC, 0xCC, 0xCC, 0x7E, 0x00}, // A3h ú
+	{0x00, 0xF8, 0x00, 0xF8, 0xCC, 0xCC, 0xCC, 0x00}, // A4h ñ
+	{0xFC, 0x00, 0xCC, 0xEC, 0xFC, 0xDC, 0xCC, 0x00}, // A5h Ñ
+	{0x3C, 0x6C, 0x6C, 0x3E, 0x00, 0x7E, 0x00, 0x00}, // A6h ª
+	{0x38, 0x6C, 0x6C, 0x38, 0x00, 0x7C, 0x00, 0x00}, // A7h º
+	{0x30, 0x00, 0x30, 0x60, 0xC0, 0xCC, 0x78, 0x00}, // A8h ¿
+	{0x00, 0x00, 0x00, 0xFC, 0xC0, 0xC0, 0x00, 0x00}, // A9h ⌐
+	{0x00, 0x00, 0x00, 0xFC, 0x0C, 0x0C, 0x00, 0x00}, // AAh ¬
+	{0xC3, 0xC6, 0xCC, 0xDE, 0x33, 0x66, 0xCC, 0x0F}, // ABh ½
+	{0xC3, 0xC6, 0xCC, 0xDB, 0x37, 0x6F, 0xCF, 0x03}, // ACh ¼
+	{0x18, 0x18, 0x00, 0x18, 0x18, 0x18, 0x18, 0x00}, // ADh ¡
+	{0x00, 0x33, 0x66, 0xCC, 0x66, 0x33, 0x00, 0x00}, // AEh «
+	{0x00, 0xCC, 0x66, 0x33, 0x66, 0xCC, 0x00, 0x00}, // AFh »
+	{0x22, 0x88, 0x22, 0x88, 0x22, 0x88, 0x22, 0x88}, // B0h ░
+	{0x55, 0xAA, 0x55, 0xAA, 0x55, 0xAA, 0x55, 0xAA}, // B1h ▒
+	{0xDB, 0x77, 0xDB, 0xEE, 0xDB, 0x77, 0xDB, 0xEE}, // B2h ▓
+	{0x18, 0x18, 0x18, 0x18, 0x18, 0x18, 0x18, 0x18}, // B3h │
+	{0x18, 0x18, 0x18, 0x18, 0xF8, 0x18, 0x18, 0x18}, // B4h ┤
+	{0x18, 0x18, 0xF8, 0x18, 0xF8, 0x18, 0x18, 0x18}, // B5h ╡
+	{0x36, 0x36, 0x36, 0x36, 0xF6, 0x36, 0x36, 0x36}, // B6h ╢
+	{0x00, 0x00, 0x00, 0x00, 0xFE, 0x36, 0x36, 0x36}, // B7h ╖
+	{0x00, 0x00, 0xF8, 0x18, 0xF8, 0x18, 0x18, 0x18}, // B8h ╕
+	{0x36, 0x36, 0xF6, 0x06, 0xF6, 0x36, 0x36, 0x36}, // B9h ╣
+	{0x36, 0x36, 0x36, 0x36, 0x36, 0x36, 0x36, 0x36}, // BAh ║
+	{0x00, 0x00, 0xFE, 0x06, 0xF6, 0x36, 0x36, 0x36}, // BBh ╗
+	{0x36, 0x36, 0xF6, 0x06, 0xFE, 0x00, 0x00, 0x00}, // BCh ╝
+	{0x36, 0x36, 0x36, 0x36, 0xFE, 0x00, 0x00, 0x00}, // BDh ╜
+	{0x18, 0x18, 0xF8, 0x18, 0xF8, 0x00, 0x00, 0x00}, // BEh ╛
+	{0x00, 0x00, 0x00, 0x00, 0xF8, 0x18, 0x18, 0x18}, // BFh ┐
+	{0x18, 0x18, 0x18, 0x18, 0x1F, 0x00, 0x00, 0x00}, // C0h └
+	{0x18, 0x18, 0x18, 0x18, 0xFF, 0x00, 0x00, 0x00}, // C1h ┴
+	{0x00, 0x00, 0x00, 0x00, 0xFF, 0x18, 0x18, 0x18}, // C2h ┬
+	{0x18, 0x18, 0x18, 0x18, 0x1F, 0x18, 0x18, 0x18}, // C3h ├
+	{0x00, 0x00, 0x00, 0x00, 0xFF, 0x00, 0x00, 0x00}, // C4h ─
+	{0x18, 0x18, 0x18, 0x18, 0xFF, 0x18, 0x18, 0x18}, // C5h ┼
+	{0x18, 0x18, 0x1F, 0x18, 0x1F, 0x18, 0x18, 0x18}, // C6h ╞
+	{0x36, 0x36, 0x36, 0x36, 0x37, 0x36, 0x36, 0x36}, // C7h ╟
+	{0x36, 0x36, 0x37, 0x30, 0x3F, 0x00, 0x00, 0x00}, // C8h ╚
+	{0x00, 0x00, 0x3F, 0x30, 0x37, 0x36, 0x36, 0x36}, // C9h ╔
+	{0x36, 0x36, 0xF7, 0x00, 0xFF, 0x00, 0x00, 0x00}, // CAh ╩
+	{0x00, 0x00, 0xFF, 0x00, 0xF7, 0x36, 0x36, 0x36}, // CBh ╦
+	{0x36, 0x36, 0x37, 0x30, 0x37, 0x36, 0x36, 0x36}, // CCh ╠
+	{0x00, 0x00, 0xFF, 0x00, 0xFF, 0x00, 0x00, 0x00}, // CDh ═
+	{0x36, 0x36, 0xF7, 0x00, 0xF7, 0x36, 0x36, 0x36}, // CEh ╬
+	{0x18, 0x18, 0xFF, 0x00, 0xFF, 0x00, 0x00, 0x00}, // CFh ╧
+	{0x36, 0x36, 0x36, 0x36, 0xFF, 0x00, 0x00, 0x00}, // D0h ╨
+	{0x00, 0x00, 0xFF, 0x00, 0xFF, 0x18, 0x18, 0x18}, // D1h ╤
+	{0x00, 0x00, 0x00, 0x00, 0xFF, 0x36, 0x36, 0x36}, // D2h ╥
+	{0x36, 0x36, 0x36, 0x36, 0x3F, 0x00, 0x00, 0x00}, // D3h ╙
+	{0x18, 0x18, 0x1F, 0x18, 0x1F, 0x00, 0x00, 0x00}, // D4h ╘
+	{0x00, 0x00, 0x1F, 0x18, 0x1F, 0x18, 0x18, 0x18}, // D5h ╒
+	{0x00, 0x00, 0x00, 0x00, 0x3F, 0x36, 0x36, 0x36}, // D6h ╓
+	{0x36, 0x36, 0x36, 0x36, 0xFF, 0x36, 0x36, 0x36}, // D7h ╫
+	{0x18, 0x18, 0xFF, 0x18, 0xFF, 0x18, 0x18, 0x18}, // D8h ╪
+	{0x18, 0x18, 0x18, 0x18, 0xF8, 0x00, 0x00, 0x00}, // D9h ┘
+	{0x00, 0x00, 0x00, 0x00, 0x1F, 0x18, 0x18, 0x18}, // DAh ┌
+	{0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF}, // DBh █
+	{0x00, 0x00, 0x00, 0x00, 0xFF, 0xFF, 0xFF, 0xFF}, // DCh ▄
+	{0xF0, 0xF0, 0xF0, 0xF0, 0xF0, 0xF0, 0xF0, 0xF0}, // DDh ▌
+	{0x0F, 0x0F, 0x0F, 0x0F, 0x0F, 0x0F, 0x0F, 0x0F}, // DEh ▐
+	{0xFF, 0xFF, 0xFF, 0xFF, 0x00, 0x00, 0x00, 0x00}, // DFh ▀
+	{0x00, 0x00, 0x76, 0xDC, 0xC8, 0xDC, 0x76, 0x00}, // E0h α
+	{0x00, 0x78, 0xCC, 0xF8, 0xCC, 0xF8, 0xC0, 0xC0}, // E1h ß
+	{0x00, 0xFC, 0xCC, 0xC0, 0xC0, 0xC0, 0xC0, 0x00}, // E2h Γ
+	{0x00, 0xFE, 0x6C, 0x6C, 0x6C, 0x6C, 0x6C, 0x00}, // E3h π
+	{0xFC, 0xCC, 0x60, 0x30, 0x60, 0xCC, 0xFC, 0x00}, // E4h Σ
+	{0x00, 0x00, 0x7E, 0xD8, 0xD8, 0xD8, 0x70, 0x00}, // E5h σ
+	{0x00, 0x66, 0x66, 0x66, 0x66, 0x7C, 0x60, 0xC0}, // E6h µ
+	{0x00, 0x76, 0xDC, 0x18, 0x18, 0x18, 0x18, 0x00}, // E7h τ
+	{0xFC, 0x30, 0x78, 0xCC, 0xCC, 0x78, 0x30, 0xFC}, // E8h Φ
+	{0x38, 0x6C, 0xC6, 0xFE, 0xC6, 0x6C, 0x38, 0x00}, // E9h Θ
+	{0x38, 0x6C, 0xC6, 0xC6, 0x6C, 0x6C, 0xEE, 0x00}, // EAh Ω
+	{0x1C, 0x30, 0x18, 0x7C, 0xCC, 0xCC, 0x78, 0x00}, // EBh δ
+	{0x00, 0x00, 0x7E, 0xDB, 0xDB, 0x7E, 0x00, 0x00}, // ECh ∞
+	{0x06, 0x0C, 0x7E, 0xDB, 0xDB, 0x7E, 0x60, 0xC0}, // EDh φ
+	{0x38, 0x60, 0xC0, 0xF8, 0xC0, 0x60, 0x38, 0x00}, // EEh ε
+	{0x78, 0xCC, 0xCC, 0xCC, 0xCC, 0xCC, 0xCC, 0x00}, // EFh ∩
+	{0x00, 0xFC, 0x00, 0xFC, 0x00, 0xFC, 0x00, 0x00}, // F0h ≡
+	{0x30, 0x30, 0xFC, 0x30, 0x30, 0x00, 0xFC, 0x00}, // F1h ±
+	{0x60, 0x30, 0x18, 0x30, 0x60, 0x00, 0xFC, 0x00}, // F2h ≥
+	{0x18, 0x30, 0x60, 0x30, 0x18, 0x00, 0xFC, 0x00}, // F3h ≤
+	{0x0E, 0x1B, 0x1B, 0x18, 0x18, 0x18, 0x18, 0x18}, // F4h ⌠
+	{0x18, 0x18, 0x18, 0x18, 0x18, 0xD8, 0xD8, 0x70}, // F5h ⌡
+	{0x30, 0x30, 0x00, 0xFC, 0x00, 0x30, 0x30, 0x00}, // F6h ÷
+	{0x00, 0x76, 0xDC, 0x00, 0x76, 0xDC, 0x00, 0x00}, // F7h ≈
+	{0x38, 0x6C, 0x6C, 0x38, 0x00, 0x00, 0x00, 0x00}, // F8h °
+	{0x00, 0x00, 0x00, 0x18, 0x18, 0x00, 0x00, 0x00}, // F9h ∙
+	{0x00, 0x00, 0x00, 0x00, 0x18, 0x00, 0x00, 0x00}, // FAh ·
+	{0x0F, 0x0C, 0x0C, 0x0C, 0xEC, 0x6C, 0x3C, 0x1C}, // FBh √
+	{0x78, 0x6C, 0x6C, 0x6C, 0x6C, 0x00, 0x00, 0x00}, // FCh ⁿ
+	{0x70, 0x18, 0x30, 0x60, 0x78, 0x00, 0x00, 0x00}, // FDh ²
+	{0x00, 0x00, 0x3C, 0x3C, 0x3C, 0x3C, 0x00, 0x00}, // FEh ■
+	{0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00}, // FFh
+}
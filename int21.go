@@ -0,0 +1,473 @@
+package cpu
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"strings"
+	"time"
+)
+
+// DOS emulates the common INT 21h services and INT 20h in Go. Install it on
+// a machine before running a DOS program.
+type DOS struct {
+	// Stdout receives the console output and writes to handles 1 and 2
+	Stdout io.Writer
+	// Stdin is the console input and reads from handle 0. No input is an empty stream
+	Stdin io.Reader
+	// Root is the host directory files are created and opened in. File
+	// services fail with "access denied" if it's nil
+	Root *os.Root
+	// Now returns the date and time of AH=2Ah and AH=2Ch, time.Now if it's nil
+	Now func() time.Time
+	// ExitCode is the code the program terminated with by AH=4Ch
+	ExitCode uint8
+
+	in    *bufio.Reader
+	files map[uint16]*os.File
+}
+
+// The standard handles: stdin, stdout, stderr, stdaux and stdprn. The handles
+// of opened files go after them
+const (
+	handleStdin    = 0
+	handleStdout   = 1
+	handleStderr   = 2
+	firstFreeFile  = 5
+	maxOpenedFiles = 20
+)
+
+// DOS error codes, returned in AX with CF set
+const (
+	dosErrInvalidFunction = 0x01
+	dosErrFileNotFound    = 0x02
+	dosErrTooManyFiles    = 0x04
+	dosErrAccessDenied    = 0x05
+	dosErrInvalidHandle   = 0x06
+	dosErrInvalidAccess   = 0x0C
+)
+
+// dosError is a failure of a file service which is reported to the program
+type dosError uint16
+
+func (e dosError) Error() string { return fmt.Sprintf("DOS error 0x%02X", uint16(e)) }
+
+// Install makes the machine call the DOS on INT 20h and INT 21h
+func (d *DOS) Install(m *Machine) {
+	if m.Interrupts == nil {
+		m.Interrupts = make(map[uint8]InterruptHandler)
+	}
+	m.Interrupts[0x20] = func(m *Machine) error { return d.terminate(m, 0) }
+	m.Interrupts[0x21] = d.int21
+}
+
+// Close closes the files the program left open
+func (d *DOS) Close() error {
+	var errs []error
+	for h, f := range d.files {
+		errs = append(errs, f.Close())
+		delete(d.files, h)
+	}
+	return errors.Join(errs...)
+}
+
+func (d *DOS) int21(m *Machine) error {
+	switch ah := m.Reg(AH); ah {
+	case 0x01:
+		// Read a character with echo
+		c, err := d.readKey()
+		if err != nil {
+			return err
+		}
+		m.SetReg(AL, uint16(c))
+		return d.write([]byte{c})
+	case 0x02:
+		// Write a character
+		return d.write([]byte{byte(m.Reg(DL))})
+	case 0x06:
+		// Direct console I/O: DL=FFh reads a character if there is one, ZF is set if not
+		if m.Reg(DL) != 0xFF {
+			return d.write([]byte{byte(m.Reg(DL))})
+		}
+		c, ok, err := d.readConsole()
+		if err != nil {
+			return err
+		}
+		m.SetReg(AL, uint16(c))
+		m.setFlag(FlagZF, !ok)
+		return nil
+	case 0x09:
+		// Write a string terminated by "$"
+		return d.write(m.terminated(m.Reg(DX), '$'))
+	case 0x0A:
+		// Buffered input
+		return d.readLine(m)
+	case 0x2A:
+		now := d.now()
+		m.SetReg(CX, uint16(now.Year()))
+		m.SetReg(DH, uint16(now.Month()))
+		m.SetReg(DL, uint16(now.Day()))
+		m.SetReg(AL, uint16(now.Weekday()))
+		return nil
+	case 0x2C:
+		now := d.now()
+		m.SetReg(CH, uint16(now.Hour()))
+		m.SetReg(CL, uint16(now.Minute()))
+		m.SetReg(DH, uint16(now.Second()))
+		m.SetReg(DL, uint16(now.Nanosecond()/10_000_000))
+		return nil
+	case 0x3C, 0x3D, 0x3E, 0x3F, 0x40, 0x42:
+		ax, err := d.fileService(m, byte(ah))
+		var code dosError
+		if errors.As(err, &code) {
+			m.SetReg(AX, uint16(code))
+			m.setFlag(FlagCF, true)
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		m.SetReg(AX, ax)
+		m.setFlag(FlagCF, false)
+		return nil
+	case 0x4C:
+		return d.terminate(m, uint8(m.Reg(AL)))
+	default:
+		return fmt.Errorf("unsupported INT 21h function AH=%02Xh", ah)
+	}
+}
+
+func (d *DOS) terminate(m *Machine, code uint8) error {
+	d.ExitCode = code
+	m.Halt()
+	return d.Close()
+}
+
+// fileService runs the handle functions and returns the value of AX. Errors
+// the program should see are dosError
+func (d *DOS) fileService(m *Machine, ah byte) (uint16, error) {
+	switch ah {
+	case 0x3C:
+		// Create or truncate a file, CX attributes are ignored
+		name, err := d.resolve(m.asciiz(m.Reg(DX)))
+		if err != nil {
+			return 0, err
+		}
+		f, err := d.Root.Create(name)
+		if err != nil {
+			return 0, fileError(err)
+		}
+		return d.addFile(f)
+	case 0x3D:
+		// Open a file, AL is the access mode
+		flags := map[uint16]int{0: os.O_RDONLY, 1: os.O_WRONLY, 2: os.O_RDWR}
+		flag, ok := flags[m.Reg(AL)&0b111]
+		if !ok {
+			return 0, dosError(dosErrInvalidAccess)
+		}
+		name, err := d.resolve(m.asciiz(m.Reg(DX)))
+		if err != nil {
+			return 0, err
+		}
+		f, err := d.Root.OpenFile(name, flag, 0)
+		if err != nil {
+			return 0, fileError(err)
+		}
+		return d.addFile(f)
+	case 0x3E:
+		h := m.Reg(BX)
+		if h < firstFreeFile {
+			return 0, nil
+		}
+		f, err := d.file(h)
+		if err != nil {
+			return 0, err
+		}
+		delete(d.files, h)
+		return 0, fileError(f.Close())
+	case 0x3F:
+		buf := make([]byte, m.Reg(CX))
+		n, err := d.readHandle(m.Reg(BX), buf)
+		if err != nil {
+			return 0, err
+		}
+		for i, b := range buf[:n] {
+			m.Memory[m.physical(DS, m.Reg(DX)+uint16(i))] = b
+		}
+		return uint16(n), nil
+	case 0x40:
+		buf := make([]byte, m.Reg(CX))
+		for i := range buf {
+			buf[i] = m.Memory[m.physical(DS, m.Reg(DX)+uint16(i))]
+		}
+		n, err := d.writeHandle(m.Reg(BX), buf)
+		return uint16(n), err
+	case 0x42:
+		// Seek: AL is the origin, CX:DX — the offset. The position goes to DX:AX
+		if m.Reg(AL) > io.SeekEnd {
+			return 0, dosError(dosErrInvalidFunction)
+		}
+		if m.Reg(BX) < firstFreeFile {
+			m.SetReg(DX, 0)
+			return 0, nil
+		}
+		f, err := d.file(m.Reg(BX))
+		if err != nil {
+			return 0, err
+		}
+		offset := int64(int32(uint32(m.Reg(CX))<<16 | uint32(m.Reg(DX))))
+		pos, err := f.Seek(offset, int(m.Reg(AL)))
+		if err != nil {
+			return 0, fileError(err)
+		}
+		m.SetReg(DX, uint16(pos>>16))
+		return uint16(pos), nil
+	default:
+		panic(fmt.Sprintf("not a file service: AH=%02Xh", ah))
+	}
+}
+
+func (d *DOS) addFile(f *os.File) (uint16, error) {
+	if d.files == nil {
+		d.files = make(map[uint16]*os.File)
+	}
+	for h := uint16(firstFreeFile); h < maxOpenedFiles; h++ {
+		if _, ok := d.files[h]; !ok {
+			d.files[h] = f
+			return h, nil
+		}
+	}
+	f.Close()
+	return 0, dosError(dosErrTooManyFiles)
+}
+
+func (d *DOS) file(h uint16) (*os.File, error) {
+	f, ok := d.files[h]
+	if !ok {
+		return nil, dosError(dosErrInvalidHandle)
+	}
+	return f, nil
+}
+
+// readHandle reads a line from stdin, as DOS does for the console, or a
+// block from a file
+func (d *DOS) readHandle(h uint16, buf []byte) (int, error) {
+	if h == handleStdin {
+		for n := range buf {
+			c, ok, err := d.readByte()
+			if err != nil || !ok {
+				return n, err
+			}
+			buf[n] = c
+			if c == '\n' {
+				return n + 1, nil
+			}
+		}
+		return len(buf), nil
+	}
+
+	f, err := d.file(h)
+	if err != nil {
+		return 0, err
+	}
+	n, err := io.ReadFull(f, buf)
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		err = nil
+	}
+	if err != nil {
+		return n, fileError(err)
+	}
+	return n, nil
+}
+
+// writeHandle writes to stdout or a file. Writing 0 bytes to a file
+// truncates it at the current position
+func (d *DOS) writeHandle(h uint16, buf []byte) (int, error) {
+	if h == handleStdout || h == handleStderr {
+		return len(buf), d.write(buf)
+	}
+
+	f, err := d.file(h)
+	if err != nil {
+		return 0, err
+	}
+	if len(buf) == 0 {
+		pos, err := f.Seek(0, io.SeekCurrent)
+		if err == nil {
+			err = f.Truncate(pos)
+		}
+		return 0, fileError(err)
+	}
+	n, err := f.Write(buf)
+	if err != nil {
+		return n, fileError(err)
+	}
+	return n, nil
+}
+
+// resolve maps a DOS path onto the root: the drive is dropped, backslashes
+// become slashes and ".." stops at the root. The last element matches an
+// existing file regardless of the case as DOS names do
+func (d *DOS) resolve(name string) (string, error) {
+	if d.Root == nil {
+		return "", dosError(dosErrAccessDenied)
+	}
+
+	if len(name) >= 2 && name[1] == ':' {
+		name = name[2:]
+	}
+	name = strings.TrimPrefix(path.Clean("/"+strings.ReplaceAll(name, `\`, "/")), "/")
+	if name == "" {
+		return "", dosError(dosErrFileNotFound)
+	}
+
+	if _, err := d.Root.Stat(name); err == nil {
+		return name, nil
+	}
+
+	dir, base := path.Split(name)
+	f, err := d.Root.Open(path.Join(".", dir))
+	if err != nil {
+		return name, nil
+	}
+	defer f.Close()
+
+	entries, _ := f.ReadDir(-1)
+	for _, e := range entries {
+		if strings.EqualFold(e.Name(), base) {
+			return path.Join(dir, e.Name()), nil
+		}
+	}
+	return name, nil
+}
+
+// fileError turns a host error into the DOS error code
+func fileError(err error) error {
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, fs.ErrNotExist):
+		return dosError(dosErrFileNotFound)
+	default:
+		return dosError(dosErrAccessDenied)
+	}
+}
+
+func (d *DOS) write(b []byte) error {
+	if d.Stdout == nil {
+		return nil
+	}
+	_, err := d.Stdout.Write(b)
+	return err
+}
+
+// readByte reads a byte of stdin. ok is false at the end of the input
+func (d *DOS) readByte() (c byte, ok bool, err error) {
+	if d.Stdin == nil {
+		return 0, false, nil
+	}
+	if d.in == nil {
+		d.in = bufio.NewReader(d.Stdin)
+	}
+
+	c, err = d.in.ReadByte()
+	if errors.Is(err, io.EOF) {
+		return 0, false, nil
+	}
+	return c, err == nil, err
+}
+
+// NOTE: the Enter key is CR, Ctrl+Z is the end of input
+const (
+	keyEnter = '\r'
+	keyEOF   = 0x1A
+)
+
+// readKey reads a key for the console functions, Ctrl+Z at the end of input
+func (d *DOS) readKey() (byte, error) {
+	c, ok, err := d.readConsole()
+	if err == nil && !ok {
+		return keyEOF, nil
+	}
+	return c, err
+}
+
+// readConsole reads a byte of the console input. Host line ends are the
+// Enter key
+func (d *DOS) readConsole() (c byte, ok bool, err error) {
+	c, ok, err = d.readByte()
+	switch {
+	case err != nil || !ok:
+		return c, ok, err
+	case c == '\n':
+		return keyEnter, true, nil
+	case c == '\r':
+		if next, err := d.in.Peek(1); err == nil && next[0] == '\n' {
+			_, _ = d.in.ReadByte()
+		}
+	}
+	return c, true, nil
+}
+
+// readLine implements AH=0Ah. The buffer at DS:DX starts with its size and
+// the number of read characters, then the characters and CR go
+func (d *DOS) readLine(m *Machine) error {
+	var (
+		buf  = m.Reg(DX)
+		size = int(m.Memory[m.physical(DS, buf)])
+		line []byte
+	)
+
+	for {
+		c, err := d.readKey()
+		if err != nil {
+			return err
+		}
+		if c == keyEnter || c == keyEOF {
+			break
+		}
+		// NOTE: the last byte is for CR, extra characters are dropped as DOS beeps on them
+		if len(line) < size-1 {
+			line = append(line, c)
+		}
+	}
+
+	if size == 0 {
+		return nil
+	}
+
+	m.Memory[m.physical(DS, buf+1)] = byte(len(line))
+	for i, c := range append(line, keyEnter) {
+		m.Memory[m.physical(DS, buf+2+uint16(i))] = c
+	}
+	return d.write(append(line, keyEnter))
+}
+
+func (d *DOS) now() time.Time {
+	if d.Now == nil {
+		return time.Now()
+	}
+	return d.Now()
+}
+
+// asciiz returns the zero-terminated string at DS:offset
+func (m *Machine) asciiz(offset uint16) string {
+	return string(m.terminated(offset, 0))
+}
+
+// terminated returns the string at DS:offset up to the end byte, at most a segment long
+func (m *Machine) terminated(offset uint16, end byte) []byte {
+	var out []byte
+	for i := range 0x10000 {
+		c := m.Memory[m.physical(DS, offset+uint16(i))]
+		if c == end {
+			break
+		}
+		out = append(out, c)
+	}
+	return out
+}
@@ -0,0 +1,196 @@
+package cpu_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	cpu "cpu8086"
+)
+
+// runDOS runs a .COM program with the DOS installed and returns its console output
+func runDOS(t *testing.T, dos *cpu.DOS, src string) (*cpu.Machine, string) {
+	t.Helper()
+
+	var out strings.Builder
+	dos.Stdout = &out
+
+	m := cpu.NewMachine()
+	dos.Install(m)
+	_, err := runProgram(t, m, 0x100, src)
+	require.NoError(t, err)
+
+	return m, out.String()
+}
+
+func TestDOSConsoleOutput(t *testing.T) {
+	var dos cpu.DOS
+	_, out := runDOS(t, &dos, `
+mov ah, 9
+mov dx, msg
+int 21h
+mov ah, 2
+mov dl, '!'
+int 21h
+ret
+msg: db 'Hello$'
+`)
+	require.Equal(t, "Hello!", out)
+	require.Equal(t, uint8(0), dos.ExitCode)
+}
+
+func TestDOSConsoleInput(t *testing.T) {
+	dos := cpu.DOS{Stdin: strings.NewReader("y\nabcd\n")}
+	m, out := runDOS(t, &dos, `
+mov ah, 1
+int 21h
+mov bl, al
+mov ah, 1
+int 21h
+mov bh, al
+mov ah, 0ah
+mov si, buf
+mov dx, si
+int 21h
+mov ah, 6
+mov dl, 0ffh
+int 21h
+mov ah, 4ch
+mov al, 3
+int 21h
+buf: db 4
+times 6 db 0
+`)
+	require.Equal(t, "y\rabc\r", out)
+	require.Equal(t, uint16('\r'<<8|'y'), m.Reg(cpu.BX))
+	// NOTE: the input is over
+	require.NotZero(t, m.Flags&cpu.FlagZF)
+	require.Equal(t, uint8(3), dos.ExitCode)
+
+	buf := 0x10000 + int(m.Reg(cpu.SI))
+	require.Equal(t, []byte("\x04\x03abc\r"), m.Memory[buf:buf+6])
+}
+
+func TestDOSDirectConsoleInput(t *testing.T) {
+	dos := cpu.DOS{Stdin: strings.NewReader("x\n")}
+	m, _ := runDOS(t, &dos, `
+mov ah, 6
+mov dl, 0ffh
+int 21h
+mov bl, al
+int 21h
+mov bh, al
+mov ah, 4ch
+int 21h
+`)
+	// NOTE: the line end is the Enter key, like for AH=01h and 0Ah
+	require.Equal(t, uint16('\r'<<8|'x'), m.Reg(cpu.BX))
+	require.Zero(t, m.Flags&cpu.FlagZF)
+}
+
+func TestDOSFiles(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "in.txt"), []byte("hello world"), 0o600))
+
+	root, err := os.OpenRoot(dir)
+	require.NoError(t, err)
+	t.Cleanup(func() { root.Close() })
+
+	dos := cpu.DOS{Root: root}
+	m, out := runDOS(t, &dos, `
+mov ah, 3ch
+mov cx, 0
+mov dx, out_name
+int 21h
+mov bx, ax
+mov ah, 40h
+mov cx, 4
+mov dx, data
+int 21h
+mov ah, 3eh
+int 21h
+mov ax, 3d00h
+mov dx, in_name
+int 21h
+mov bx, ax
+mov ax, 4200h
+mov cx, 0
+mov dx, 6
+int 21h
+mov ah, 3fh
+mov cx, 8
+mov dx, buf
+int 21h
+mov cx, ax
+mov ah, 3eh
+int 21h
+mov ah, 40h
+mov bx, 1
+mov dx, buf
+int 21h
+mov ax, 3d00h
+mov dx, escape
+int 21h
+hlt
+out_name: db 'OUT.TXT', 0
+in_name: db 'C:\IN.TXT', 0
+escape: db '..\..\etc\passwd', 0
+data: db 'data'
+buf: times 8 db 0
+`)
+	require.Equal(t, "world", out)
+
+	got, err := os.ReadFile(filepath.Join(dir, "OUT.TXT"))
+	require.NoError(t, err)
+	require.Equal(t, "data", string(got))
+
+	// NOTE: ".." stops at the root, so the file isn't found
+	require.Equal(t, uint16(2), m.Reg(cpu.AX))
+	require.NotZero(t, m.Flags&cpu.FlagCF)
+}
+
+func TestDOSFilesWithoutRoot(t *testing.T) {
+	var dos cpu.DOS
+	m, _ := runDOS(t, &dos, `
+mov ax, 3d00h
+mov dx, name
+int 21h
+hlt
+name: db 'A.TXT', 0
+`)
+	require.Equal(t, uint16(5), m.Reg(cpu.AX))
+	require.NotZero(t, m.Flags&cpu.FlagCF)
+}
+
+func TestDOSDateTime(t *testing.T) {
+	dos := cpu.DOS{Now: func() time.Time {
+		return time.Date(2024, time.March, 9, 13, 45, 30, 250_000_000, time.UTC)
+	}}
+	m, _ := runDOS(t, &dos, `
+mov ah, 2ah
+int 21h
+mov si, cx
+mov di, dx
+mov bl, al
+mov ah, 2ch
+int 21h
+ret
+`)
+	require.Equal(t, uint16(2024), m.Reg(cpu.SI))
+	require.Equal(t, uint16(0x0309), m.Reg(cpu.DI))
+	// NOTE: Saturday
+	require.Equal(t, uint16(6), m.Reg(cpu.BL))
+	require.Equal(t, uint16(13<<8|45), m.Reg(cpu.CX))
+	require.Equal(t, uint16(30<<8|25), m.Reg(cpu.DX))
+}
+
+func TestDOSUnsupportedFunction(t *testing.T) {
+	m := cpu.NewMachine()
+	new(cpu.DOS).Install(m)
+	_, err := runProgram(t, m, 0x100, "mov ah, 0ffh\nint 21h\n")
+	require.EqualError(t, err, "offset 0x102: int 33: unsupported INT 21h function AH=FFh")
+}
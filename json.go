@@ -0,0 +1,189 @@
+package cpu
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+)
+
+// Format is an output format of Disassemble
+type Format uint8
+
+const (
+	// FormatText is NASM source (the default)
+	FormatText Format = iota
+	// FormatJSON is a JSON array of JSONInstruction
+	FormatJSON
+	// FormatNDJSON is one JSONInstruction object per line
+	FormatNDJSON
+)
+
+// WithFormat selects the output format
+func WithFormat(format Format) Option {
+	return func(o *options) {
+		o.format = format
+	}
+}
+
+// JSONInstruction is the schema of a decoded instruction. Fields are never
+// renamed or removed, new ones could be added.
+type JSONInstruction struct {
+	Offset   int           `json:"offset"`
+	Length   int           `json:"length"`
+	Bytes    string        `json:"bytes"` // hex, e.g. "89d9"
+	Mnemonic string        `json:"mnemonic"`
+	Operands []JSONOperand `json:"operands"` // destination goes first
+	Prefixes []string      `json:"prefixes"`
+	Target   *int          `json:"target,omitempty"` // absolute offset of a jump target
+}
+
+// JSONOperand is the schema of an operand
+type JSONOperand struct {
+	Kind  string `json:"kind"`  // "reg", "imm", "mem" or "rel"
+	Width int    `json:"width"` // 8 or 16 bits
+	// Register is set for "reg"
+	Register string `json:"register,omitempty"`
+	// Immediate is set for "imm"
+	Immediate *int `json:"immediate,omitempty"`
+	// Registers are set for "mem", omitted for a direct address
+	Registers []string `json:"registers,omitempty"`
+	// Displacement is set for "mem" (the address if direct) and "rel"
+	Displacement *int `json:"displacement,omitempty"`
+}
+
+func printJSON(insts []decodedInst, format Format) string {
+	var b strings.Builder
+
+	objects := make([]JSONInstruction, 0, len(insts))
+	for _, d := range insts {
+		objects = append(objects, d.json())
+	}
+
+	if format == FormatNDJSON {
+		enc := json.NewEncoder(&b)
+		for _, obj := range objects {
+			// NOTE: the schema has only marshalable fields
+			_ = enc.Encode(obj)
+		}
+		return b.String()
+	}
+
+	out, _ := json.MarshalIndent(objects, "", "  ")
+	b.Write(out)
+	b.WriteString("\n")
+
+	return b.String()
+}
+
+func (d decodedInst) json() JSONInstruction {
+	var (
+		inst = d.inst
+		out  = JSONInstruction{
+			Offset:   d.offset,
+			Length:   d.size,
+			Bytes:    hex.EncodeToString(d.raw),
+			Mnemonic: inst.mnemonic.String(),
+			Operands: []JSONOperand{},
+			Prefixes: []string{},
+		}
+	)
+
+	switch d.data {
+	case dataBytes:
+		out.Mnemonic = "db"
+		for _, b := range d.raw {
+			v := int(b)
+			out.Operands = append(out.Operands, JSONOperand{Kind: "imm", Width: 8, Immediate: &v})
+		}
+		return out
+	case dataWords:
+		out.Mnemonic = "dw"
+		for _, w := range d.words() {
+			v := int(w)
+			out.Operands = append(out.Operands, JSONOperand{Kind: "imm", Width: 16, Immediate: &v})
+		}
+		return out
+	}
+
+	if d.rule.JMP {
+		disp := int(inst.jump)
+		target := d.offset + d.size + disp
+		out.Target = &target
+		out.Operands = append(out.Operands, JSONOperand{Kind: "rel", Width: 8, Displacement: &disp})
+		return out
+	}
+
+	width := inst.width()
+	for _, o := range [...]Operand{inst.dst, inst.src} {
+		if o.kind != 0 {
+			out.Operands = append(out.Operands, o.json(width))
+		}
+	}
+
+	return out
+}
+
+// width returns the size of data the instruction works with: 8 or 16 bits
+func (inst Instruction) width() int {
+	operands := [...]Operand{inst.dst, inst.src}
+	// NOTE: the port of OUT goes first, but the data is in the accumulator
+	if inst.mnemonic == OUT {
+		operands[0], operands[1] = operands[1], operands[0]
+	}
+	for _, o := range operands {
+		switch {
+		case o.kind == opKindReg && o.reg >= AX:
+			return 16
+		case o.kind == opKindReg:
+			return 8
+		case o.kind == opKindImm && o.imm.word:
+			return 16
+		}
+	}
+	return 8
+}
+
+func (o Operand) json(width int) JSONOperand {
+	out := JSONOperand{Width: width}
+
+	switch o.kind {
+	case opKindReg:
+		out.Kind = "reg"
+		out.Register = o.reg.String()
+	case opKindImm:
+		out.Kind = "imm"
+		imm := int(o.imm.val)
+		out.Immediate = &imm
+	case opKindEAC:
+		out.Kind = "mem"
+		out.Registers = []string{}
+		for _, r := range [...]Register{o.eac.reg1, o.eac.reg2} {
+			if r != registerInvalid {
+				out.Registers = append(out.Registers, r.String())
+			}
+		}
+		disp := int(o.eac.dispOrDA)
+		out.Displacement = &disp
+	}
+
+	return out
+}
+
+// JSONRegisters is the schema of the registers of a machine. Registers which
+// are zero are omitted as in DumpRegisters
+type JSONRegisters struct {
+	Registers map[string]uint16 `json:"registers"`
+	IP        uint16            `json:"ip"`
+	Flags     string            `json:"flags"`
+}
+
+// JSONRegisters returns the registers of the machine in the JSON schema
+func (m *Machine) JSONRegisters() JSONRegisters {
+	out := JSONRegisters{Registers: map[string]uint16{}, IP: m.IP, Flags: m.Flags.String()}
+	for _, r := range dumpOrder {
+		if v := m.Reg(r); v != 0 {
+			out.Registers[r.String()] = v
+		}
+	}
+	return out
+}
@@ -0,0 +1,76 @@
+package cpu_test
+
+import (
+	"encoding/json"
+	"flag"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	cpu "cpu8086"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+func TestDisassembleNDJSON(t *testing.T) {
+	filenames := []string{
+		"0037_single_register_mov",
+		"0038_many_register_mov",
+		"0039_more_movs",
+		"0040_challenge_movs",
+		"0041_add_sub_cmp_jnz",
+	}
+
+	for _, name := range filenames {
+		t.Run(name, func(t *testing.T) {
+			stream, err := os.ReadFile("listings/" + name + ".bin")
+			require.NoError(t, err)
+
+			got, err := cpu.Disassemble(stream, cpu.WithFormat(cpu.FormatNDJSON))
+			require.NoError(t, err)
+
+			goldenPath := "testdata/" + name + ".ndjson"
+			if *update {
+				require.NoError(t, os.WriteFile(goldenPath, []byte(got), 0o644))
+			}
+
+			want, err := os.ReadFile(goldenPath)
+			require.NoError(t, err)
+			require.Equal(t, string(want), got)
+		})
+	}
+}
+
+func TestDisassembleJSON(t *testing.T) {
+	// add word [bp + si + 1000], 29
+	// jne $-5+0
+	stream := []byte{0x83, 0x82, 0xe8, 0x03, 0x1d, 0x75, 0xf9}
+
+	got, err := cpu.Disassemble(stream, cpu.WithFormat(cpu.FormatJSON))
+	require.NoError(t, err)
+
+	var insts []cpu.JSONInstruction
+	require.NoError(t, json.NewDecoder(strings.NewReader(got)).Decode(&insts))
+	require.Len(t, insts, 2)
+
+	add := insts[0]
+	require.Equal(t, "add", add.Mnemonic)
+	require.Equal(t, "8382e8031d", add.Bytes)
+	require.Equal(t, 5, add.Length)
+	require.Nil(t, add.Target)
+	require.Equal(t, "mem", add.Operands[0].Kind)
+	require.Equal(t, 16, add.Operands[0].Width)
+	require.Equal(t, []string{"bp", "si"}, add.Operands[0].Registers)
+	require.Equal(t, 1000, *add.Operands[0].Displacement)
+	require.Equal(t, "imm", add.Operands[1].Kind)
+	require.Equal(t, 29, *add.Operands[1].Immediate)
+
+	jne := insts[1]
+	require.Equal(t, "jne", jne.Mnemonic)
+	require.Equal(t, 5, jne.Offset)
+	require.Equal(t, 0, *jne.Target)
+	require.Equal(t, "rel", jne.Operands[0].Kind)
+	require.Equal(t, -7, *jne.Operands[0].Displacement)
+}
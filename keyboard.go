@@ -0,0 +1,183 @@
+package cpu
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Keyboard ports of the 8255 PPI
+const (
+	kbdData  = 0x60
+	ppiPortB = 0x61
+)
+
+// Port 61h bits
+const (
+	// portBTimer2Gate is the gate of PIT channel 2
+	portBTimer2Gate = 0x01
+	// portBSpeakerData lets the output of PIT channel 2 to the speaker
+	portBSpeakerData = 0x02
+	// portBClearKeyboard acknowledges the scan code to the keyboard
+	portBClearKeyboard = 0x80
+)
+
+// scanCodeClocks is the time the keyboard sends a scan code in: 11 bits at
+// about 10 kHz, a millisecond
+const scanCodeClocks = ClockRate / 1000
+
+// Keyboard emulates the PC keyboard behind the 8255 PPI: port 60h holds the
+// last scan code, port 61h controls the timer gate and acknowledges scan
+// codes. A new scan code raises IRQ1. The keystrokes are scripted: they come
+// at given clocks or when the program polls the keyboard.
+type Keyboard struct {
+	// PIC receives IRQ1 when a scan code comes
+	PIC *PIC
+	// PIT has the gate of channel 2 in bit 0 of port 61h
+	PIT *PIT
+	// Speaker is turned on by bit 1 of port 61h along with PIT channel 2
+	Speaker *Speaker
+
+	// timed are the scan codes to come at clocks, in order
+	timed []timedScanCode
+	// polled are the scan codes to come when the program polls
+	polled []byte
+
+	// code is the last scan code, full is set until it's read
+	code byte
+	full bool
+	// clocks are the machine clocks of the last step, the next timed scan
+	// code comes not before sendClocks
+	clocks, sendClocks int
+	// portB is the value written to port 61h
+	portB byte
+}
+
+type timedScanCode struct {
+	clocks int
+	code   byte
+}
+
+// Install puts the keyboard on ports 60h and 61h and steps it with the machine
+func (k *Keyboard) Install(m *Machine) {
+	m.AttachPorts(k, kbdData, ppiPortB)
+	m.Devices = append(m.Devices, k)
+}
+
+// At schedules scan codes to come at the clocks of the machine. They come
+// one after another as the program reads them
+func (k *Keyboard) At(clocks int, codes ...byte) {
+	for _, c := range codes {
+		k.timed = append(k.timed, timedScanCode{clocks, c})
+	}
+	slices.SortStableFunc(k.timed, func(a, b timedScanCode) int {
+		return a.clocks - b.clocks
+	})
+}
+
+// OnPoll queues scan codes which come when the program polls the keyboard:
+// reads port 60h or asks the BIOS for a key with nothing new to read
+func (k *Keyboard) OnPoll(codes ...byte) {
+	k.polled = append(k.polled, codes...)
+}
+
+// Poll returns the scan code which wasn't read yet or the next polled one
+func (k *Keyboard) Poll() (byte, bool) {
+	switch {
+	case k.full:
+		k.clear()
+	case len(k.polled) > 0:
+		k.code, k.polled = k.polled[0], k.polled[1:]
+	default:
+		return 0, false
+	}
+	return k.code, true
+}
+
+// Step delivers the next timed scan code once the last one is read and the
+// keyboard has sent it
+func (k *Keyboard) Step(m *Machine) {
+	k.clocks = m.Clocks
+	if k.full || len(k.timed) == 0 || max(k.timed[0].clocks, k.sendClocks) > m.Clocks {
+		return
+	}
+	k.code, k.full = k.timed[0].code, true
+	k.timed = k.timed[1:]
+	if k.PIC != nil {
+		k.PIC.Raise(1)
+	}
+}
+
+func (k *Keyboard) In(port uint16) byte {
+	if port == ppiPortB {
+		return k.portB
+	}
+	if !k.full {
+		// NOTE: a program which reads the port with nothing new polls
+		k.Poll()
+		return k.code
+	}
+	k.clear()
+	return k.code
+}
+
+func (k *Keyboard) Out(port uint16, v byte) {
+	if port != ppiPortB {
+		return
+	}
+	k.portB = v
+	if v&portBClearKeyboard != 0 && k.full {
+		k.clear()
+	}
+	// NOTE: the data bit goes first, so the output of channel 2 going high
+	// with its gate off doesn't click the speaker being turned off
+	if k.Speaker != nil {
+		k.Speaker.setData(k.clocks, v&portBSpeakerData != 0)
+	}
+	if k.PIT != nil {
+		k.PIT.SetGate(2, v&portBTimer2Gate != 0)
+	}
+}
+
+// clear marks the scan code read and withdraws its IRQ1 if it isn't served yet
+func (k *Keyboard) clear() {
+	k.full = false
+	k.sendClocks = k.clocks + scanCodeClocks
+	if k.PIC != nil {
+		k.PIC.Lower(1)
+	}
+}
+
+// ParseKeyScript schedules the scan codes of a script: entries like
+// "CLOCKS:CODE,CODE..." separated by spaces, where CLOCKS is the clock
+// count they come at or "poll" and CODEs are hexadecimal scan codes,
+// e.g. "5000:1e,9e poll:1c,9c"
+func (k *Keyboard) ParseKeyScript(script string) error {
+	for entry := range strings.FieldsSeq(script) {
+		at, list, ok := strings.Cut(entry, ":")
+		if !ok {
+			return fmt.Errorf("invalid key script entry: %s", entry)
+		}
+
+		var codes []byte
+		for s := range strings.SplitSeq(list, ",") {
+			c, err := strconv.ParseUint(s, 16, 8)
+			if err != nil {
+				return fmt.Errorf("invalid scan code: %s", s)
+			}
+			codes = append(codes, byte(c))
+		}
+
+		if at == "poll" {
+			k.OnPoll(codes...)
+			continue
+		}
+		clocks, err := strconv.Atoi(at)
+		if err != nil || clocks < 0 {
+			return fmt.Errorf("invalid clocks of scan codes: %s", at)
+		}
+		k.At(clocks, codes...)
+	}
+	return nil
+}
@@ -0,0 +1,130 @@
+package cpu_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	cpu "cpu8086"
+)
+
+func TestKeyboardInterrupts(t *testing.T) {
+	m := cpu.NewMachine()
+	keyboard := cpu.NewPC(m).Keyboard
+	keyboard.At(20000, 0x1C, 0x9C)
+	keyboard.At(100, cpu.ScanCodesOf("a")...)
+
+	n, err := runProgram(t, m, 0x1000, `
+mov word [9*4], irq1
+sti
+wait:
+hlt
+cmp byte [count], 4
+jne wait
+cli
+hlt
+irq1:
+in al, 60h
+mov bx, [count]
+mov [codes + bx], al
+add byte [count], 1
+in al, 61h
+mov ah, al
+add al, 80h
+out 61h, al
+mov al, ah
+out 61h, al
+mov al, 20h
+out 20h, al
+iret
+count: dw 0
+codes: db 0, 0, 0, 0
+`)
+	require.NoError(t, err)
+	codes := 0x1000 + n - 4
+	require.Equal(t, []byte{0x1E, 0x9E, 0x1C, 0x9C}, m.Memory[codes:codes+4])
+	require.Greater(t, m.Clocks, 20000)
+}
+
+func TestKeyboardPolling(t *testing.T) {
+	m := cpu.NewMachine()
+	keyboard := cpu.NewPC(m).Keyboard
+	keyboard.OnPoll(0x1E, 0x9E, 0x01)
+
+	_, err := runProgram(t, m, 0x1000, `
+wait:
+in al, 60h
+add cx, 1
+cmp al, 1
+jne wait
+hlt
+`)
+	require.NoError(t, err)
+	require.Equal(t, uint16(0x0001), m.Reg(cpu.AX))
+	require.Equal(t, uint16(3), m.Reg(cpu.CX))
+
+	_, ok := keyboard.Poll()
+	require.False(t, ok)
+}
+
+func TestKeyboardBIOS(t *testing.T) {
+	m := cpu.NewMachine()
+	keyboard := cpu.NewPC(m).Keyboard
+	keyboard.At(500, cpu.ScanCodesOf("Hi")...)
+	keyboard.OnPoll(0x3A, 0xBA)
+	keyboard.OnPoll(cpu.ScanCodesOf("q")...)
+
+	bios := cpu.BIOS{Keyboard: keyboard}
+	bios.Install(m)
+
+	n, err := runProgram(t, m, 0x1000, `
+mov ah, 0
+int 16h
+mov [keys], ax
+sti
+mov bx, keys + 2
+next:
+mov ah, 1
+int 16h
+jz next
+mov ah, 0
+int 16h
+mov [bx], ax
+add bx, 2
+cmp bx, keys + 6
+jne next
+cli
+hlt
+keys: dw 0, 0, 0
+`)
+	require.NoError(t, err)
+	keys := 0x1000 + n - 6
+	// NOTE: the caps lock is on, so "q" is typed in the upper case and "H" —
+	// with the shift in the lower one
+	require.Equal(t, []byte{'Q', 0x10, 'h', 0x23, 'I', 0x17}, m.Memory[keys:keys+6])
+	require.Equal(t, byte(0x40), m.Memory[0x417])
+}
+
+func TestKeyScript(t *testing.T) {
+	m := cpu.NewMachine()
+	keyboard := cpu.NewPC(m).Keyboard
+	require.NoError(t, keyboard.ParseKeyScript("poll:1e,9e 100:1c"))
+	require.EqualError(t, keyboard.ParseKeyScript("soon:1c"), "invalid clocks of scan codes: soon")
+	require.EqualError(t, keyboard.ParseKeyScript("100:1c,xyz"), "invalid scan code: xyz")
+	require.EqualError(t, keyboard.ParseKeyScript("1c"), "invalid key script entry: 1c")
+
+	code, ok := keyboard.Poll()
+	require.True(t, ok)
+	require.Equal(t, byte(0x1E), code)
+
+	m.Clocks = 100
+	keyboard.Step(m)
+	n, ok := m.PIC.Acknowledge()
+	require.True(t, ok)
+	require.Equal(t, uint8(0x09), n)
+	require.Equal(t, byte(0x1C), keyboard.In(0x60))
+}
+
+func TestScanCodesOf(t *testing.T) {
+	require.Equal(t, []byte{0x2A, 0x23, 0xA3, 0xAA, 0x17, 0x97, 0x1C, 0x9C}, cpu.ScanCodesOf("Hi\n\x00"))
+}
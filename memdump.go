@@ -0,0 +1,103 @@
+package cpu
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+)
+
+// PixelFormat is a layout of pixels in memory
+type PixelFormat uint8
+
+const (
+	// PixelRGBA is 4 bytes per pixel: red, green, blue and alpha
+	PixelRGBA PixelFormat = iota
+	// PixelIndexed is a byte per pixel: 0-15 are the CGA colors, 16-255 are
+	// gray levels from black to white
+	PixelIndexed
+)
+
+// ParsePixelFormat looks up a pixel format by its name: "rgba" or "indexed"
+func ParsePixelFormat(s string) (PixelFormat, bool) {
+	switch s {
+	case "rgba":
+		return PixelRGBA, true
+	case "indexed":
+		return PixelIndexed, true
+	default:
+		return 0, false
+	}
+}
+
+// bytesPerPixel returns the size of a pixel in memory
+func (f PixelFormat) bytesPerPixel() int {
+	if f == PixelRGBA {
+		return 4
+	}
+	return 1
+}
+
+// indexedPalette is the palette of PixelIndexed
+var indexedPalette = func() color.Palette {
+	p := make(color.Palette, 0, 256)
+	p = append(p, cgaPalette...)
+	for i := range 256 - len(cgaPalette) {
+		v := uint8(i * 255 / (255 - len(cgaPalette)))
+		p = append(p, color.RGBA{v, v, v, 0xFF})
+	}
+	return p
+}()
+
+// DumpMemory writes size bytes of memory starting at the physical address
+func (m *Machine) DumpMemory(w io.Writer, addr, size int) error {
+	if err := m.checkRange(addr, size); err != nil {
+		return err
+	}
+	_, err := w.Write(m.Memory[addr : addr+size])
+	return err
+}
+
+// MemoryImage builds a width x height image from the pixels in memory at the
+// physical address. Rows go one after another without padding
+func (m *Machine) MemoryImage(addr, width, height int, format PixelFormat) (image.Image, error) {
+	if width <= 0 || height <= 0 {
+		return nil, fmt.Errorf("invalid image size: %dx%d", width, height)
+	}
+
+	size := width * height * format.bytesPerPixel()
+	if err := m.checkRange(addr, size); err != nil {
+		return nil, err
+	}
+	pixels := m.Memory[addr : addr+size]
+
+	rect := image.Rect(0, 0, width, height)
+	if format == PixelIndexed {
+		img := image.NewPaletted(rect, indexedPalette)
+		copy(img.Pix, pixels)
+		return img, nil
+	}
+
+	// NOTE: image.RGBA is alpha-premultiplied, the memory is not
+	img := image.NewNRGBA(rect)
+	copy(img.Pix, pixels)
+	return img, nil
+}
+
+// WriteMemoryPNG encodes the image of MemoryImage as PNG
+func (m *Machine) WriteMemoryPNG(w io.Writer, addr, width, height int, format PixelFormat) error {
+	img, err := m.MemoryImage(addr, width, height, format)
+	if err != nil {
+		return err
+	}
+	return png.Encode(w, img)
+}
+
+// checkRange returns an error if the bytes aren't in the address space
+func (m *Machine) checkRange(addr, size int) error {
+	if addr < 0 || size < 0 || addr+size > len(m.Memory) {
+		return fmt.Errorf("%d bytes at 0x%05X are out of memory", size, addr)
+	}
+	return nil
+}
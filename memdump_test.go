@@ -0,0 +1,73 @@
+package cpu_test
+
+import (
+	"bytes"
+	"image/color"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	cpu "cpu8086"
+	"cpu8086/asm"
+)
+
+func TestDumpMemory(t *testing.T) {
+	program, err := asm.Assemble(`
+mov word [0x100], 0x1234
+mov byte [0x102], 0x56
+`)
+	require.NoError(t, err)
+
+	m := cpu.NewMachine()
+	m.Load(program, 0)
+	require.NoError(t, m.Run(10))
+
+	var b bytes.Buffer
+	require.NoError(t, m.DumpMemory(&b, 0x100, 4))
+	require.Equal(t, []byte{0x34, 0x12, 0x56, 0x00}, b.Bytes())
+
+	require.EqualError(t, m.DumpMemory(&b, 0xFFFFF, 2), "2 bytes at 0xFFFFF are out of memory")
+}
+
+func TestMemoryImage(t *testing.T) {
+	m := cpu.NewMachine()
+	// NOTE: a 2x2 image: red, semi-transparent green, blue, white
+	copy(m.Memory[0x100:], []byte{
+		0xFF, 0x00, 0x00, 0xFF, 0x00, 0xFF, 0x00, 0x80,
+		0x00, 0x00, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	})
+
+	img, err := m.MemoryImage(0x100, 2, 2, cpu.PixelRGBA)
+	require.NoError(t, err)
+	require.Equal(t, color.NRGBA{0xFF, 0x00, 0x00, 0xFF}, img.At(0, 0))
+	require.Equal(t, color.NRGBA{0x00, 0xFF, 0x00, 0x80}, img.At(1, 0))
+	require.Equal(t, color.NRGBA{0x00, 0x00, 0xFF, 0xFF}, img.At(0, 1))
+
+	_, err = m.MemoryImage(0x100, 0, 2, cpu.PixelRGBA)
+	require.EqualError(t, err, "invalid image size: 0x2")
+	_, err = m.MemoryImage(0xFFFFC, 2, 1, cpu.PixelRGBA)
+	require.EqualError(t, err, "8 bytes at 0xFFFFC are out of memory")
+}
+
+func TestMemoryImageIndexed(t *testing.T) {
+	m := cpu.NewMachine()
+	copy(m.Memory[0x200:], []byte{0x01, 0x0E, 0x10, 0xFF})
+
+	var b bytes.Buffer
+	require.NoError(t, m.WriteMemoryPNG(&b, 0x200, 4, 1, cpu.PixelIndexed))
+
+	img, err := png.Decode(&b)
+	require.NoError(t, err)
+
+	want := []color.RGBA{
+		{0x00, 0x00, 0xAA, 0xFF},
+		{0xFF, 0xFF, 0x55, 0xFF},
+		{0x00, 0x00, 0x00, 0xFF},
+		{0xFF, 0xFF, 0xFF, 0xFF},
+	}
+	for x, c := range want {
+		r, g, bl, a := img.At(x, 0).RGBA()
+		require.Equal(t, c, color.RGBA{uint8(r >> 8), uint8(g >> 8), uint8(bl >> 8), uint8(a >> 8)}, "pixel %d", x)
+	}
+}
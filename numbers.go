@@ -0,0 +1,91 @@
+package cpu
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// NumberFormat configures how numbers in operands are printed
+type NumberFormat struct {
+	// Hex prints immediates, displacements and addresses in hexadecimal
+	Hex bool
+	// Unsigned prints immediates and addresses as unsigned numbers of their
+	// size, e.g. 0xFFFF instead of -1 for words
+	Unsigned bool
+	// Chars prints byte immediates in the printable ASCII range as characters
+	Chars bool
+}
+
+// WithNumbers selects how numbers are printed
+func WithNumbers(nf NumberFormat) Option {
+	return func(o *options) {
+		o.numbers = nf
+	}
+}
+
+// numberStyle is how a dialect writes hexadecimals and characters
+type numberStyle struct {
+	hex  func(v int) string
+	char func(c byte) string
+}
+
+var (
+	nasmNumbers = numberStyle{hex: cHex, char: quotedChar}
+	masmNumbers = numberStyle{hex: masmHex, char: quotedChar}
+	attNumbers  = numberStyle{hex: cHex, char: attChar}
+)
+
+func (nf NumberFormat) immediate(v int16, word bool, st numberStyle) string {
+	if nf.Chars && !word && v >= ' ' && v <= '~' {
+		return st.char(byte(v))
+	}
+
+	n := int(v)
+	switch {
+	case nf.Unsigned && word:
+		n = int(uint16(v))
+	case nf.Unsigned:
+		n = int(uint8(v))
+	}
+
+	return nf.number(n, st)
+}
+
+// address formats a direct address
+func (nf NumberFormat) address(v int16, st numberStyle) string {
+	if nf.Unsigned || nf.Hex {
+		return nf.number(int(uint16(v)), st)
+	}
+	return nf.number(int(v), st)
+}
+
+func (nf NumberFormat) number(n int, st numberStyle) string {
+	switch {
+	case n < 0:
+		return "-" + nf.number(-n, st)
+	case nf.Hex:
+		return st.hex(n)
+	default:
+		return strconv.Itoa(n)
+	}
+}
+
+func cHex(v int) string {
+	return fmt.Sprintf("0x%x", v)
+}
+
+// quotedChar is a character constant of NASM and MASM
+func quotedChar(c byte) string {
+	if c == '\'' {
+		return `"'"`
+	}
+	return "'" + string(c) + "'"
+}
+
+// attChar is a character constant of GNU as: a quote followed by the character
+func attChar(c byte) string {
+	if c == '\\' || c == '\'' {
+		return `'\` + string(c)
+	}
+	return "'" + string(c)
+}
@@ -0,0 +1,102 @@
+package cpu_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	cpu "cpu8086"
+	"cpu8086/asm"
+)
+
+func TestDisassembleNumbers(t *testing.T) {
+	// mov cx, -1
+	// mov cl, 65
+	// mov ax, [40000]
+	// mov dx, [bx - 32]
+	// mov byte [bp + di], 39
+	stream := []byte{
+		0xb9, 0xff, 0xff,
+		0xb1, 0x41,
+		0xa1, 0x40, 0x9c,
+		0x8b, 0x57, 0xe0,
+		0xc6, 0x03, 0x27,
+	}
+
+	all := cpu.NumberFormat{Hex: true, Unsigned: true, Chars: true}
+
+	tests := []struct {
+		name string
+		opts []cpu.Option
+		want string
+	}{
+		{
+			name: "default",
+			want: "bits 16\n" +
+				"\nmov cx, -1" +
+				"\nmov cl, 65" +
+				"\nmov ax, [-25536]" +
+				"\nmov dx, [bx - 32]" +
+				"\nmov byte [bp + di], 39",
+		},
+		{
+			name: "unsigned",
+			opts: []cpu.Option{cpu.WithNumbers(cpu.NumberFormat{Unsigned: true})},
+			want: "bits 16\n" +
+				"\nmov cx, 65535" +
+				"\nmov cl, 65" +
+				"\nmov ax, [40000]" +
+				"\nmov dx, [bx - 32]" +
+				"\nmov byte [bp + di], 39",
+		},
+		{
+			name: "all",
+			opts: []cpu.Option{cpu.WithNumbers(all)},
+			want: "bits 16\n" +
+				"\nmov cx, 0xffff" +
+				"\nmov cl, 'A'" +
+				"\nmov ax, [0x9c40]" +
+				"\nmov dx, [bx - 0x20]" +
+				"\nmov byte [bp + di], \"'\"",
+		},
+		{
+			name: "masm",
+			opts: []cpu.Option{cpu.WithNumbers(all), cpu.WithSyntax(cpu.SyntaxMASM)},
+			want: ".8086\n" +
+				"\nmov cx, 0FFFFh" +
+				"\nmov cl, 'A'" +
+				"\nmov ax, word ptr ds:[9C40h]" +
+				"\nmov dx, word ptr [bx-20h]" +
+				"\nmov byte ptr [bp+di], \"'\"",
+		},
+		{
+			name: "att",
+			opts: []cpu.Option{cpu.WithNumbers(all), cpu.WithSyntax(cpu.SyntaxATT)},
+			want: ".code16\n" +
+				"\nmovw $0xffff, %cx" +
+				"\nmovb $'A, %cl" +
+				"\nmovw 0x9c40, %ax" +
+				"\nmovw -0x20(%bx), %dx" +
+				"\nmovb $'\\', (%bp,%di)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := cpu.Disassemble(stream, tt.opts...)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+
+	t.Run("round trip", func(t *testing.T) {
+		for _, nf := range []cpu.NumberFormat{{}, {Hex: true}, {Unsigned: true}, all} {
+			got, err := cpu.Disassemble(stream, cpu.WithNumbers(nf))
+			require.NoError(t, err)
+
+			binary, err := asm.Assemble(got)
+			require.NoError(t, err)
+			require.Equal(t, stream, binary, got)
+		}
+	})
+}
@@ -0,0 +1,25 @@
+package cpu
+
+// PC is the set of peripherals of the IBM PC on the system board
+type PC struct {
+	PIC      *PIC
+	PIT      *PIT
+	Keyboard *Keyboard
+	Speaker  *Speaker
+}
+
+// NewPC wires the PIC, the PIT, the keyboard and the speaker together as the
+// system board of the IBM PC does and installs them on the machine. The
+// timer is idle until the program programs it
+func NewPC(m *Machine) *PC {
+	var (
+		pic      = &PIC{}
+		speaker  = &Speaker{}
+		pit      = &PIT{PIC: pic, Speaker: speaker}
+		keyboard = &Keyboard{PIC: pic, PIT: pit, Speaker: speaker}
+	)
+	pic.Install(m)
+	pit.Install(m)
+	keyboard.Install(m)
+	return &PC{PIC: pic, PIT: pit, Keyboard: keyboard, Speaker: speaker}
+}
@@ -0,0 +1,60 @@
+package cpu_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	cpu "cpu8086"
+	"cpu8086/asm"
+)
+
+// runProgram assembles the program at the org and runs it until it stops.
+// A program at org 100h is a .COM program in segment 1000h, others run at
+// 0000:org with the stack below them. It returns the size of the program
+func runProgram(t *testing.T, m *cpu.Machine, org uint16, src string) (int, error) {
+	t.Helper()
+
+	program, err := asm.Assemble(fmt.Sprintf("org 0x%X\n", org) + src)
+	require.NoError(t, err)
+
+	if org == 0x100 {
+		require.NoError(t, m.LoadCOM(program, 0x1000, ""))
+	} else {
+		m.Load(program, org)
+		m.SetReg(cpu.SP, org)
+	}
+	return len(program), m.Run(100000)
+}
+
+func TestNewPC(t *testing.T) {
+	m := cpu.NewMachine()
+	pc := cpu.NewPC(m)
+	require.Equal(t, pc.PIC, m.PIC)
+	pc.Keyboard.At(100, 0x1C)
+
+	_, err := runProgram(t, m, 0x1000, `
+mov al, 0xb6
+out 43h, al
+mov al, 100
+out 42h, al
+mov al, 0
+out 42h, al
+mov al, 3
+out 61h, al
+sti
+hlt
+mov al, 0
+out 61h, al
+cli
+hlt
+`)
+	require.NoError(t, err)
+
+	// NOTE: the keyboard raises IRQ1 through the PIC and its port 61h drives
+	// the speaker along with PIT channel 2
+	require.Equal(t, byte(0x1C), pc.Keyboard.In(0x60))
+	require.NotEmpty(t, pc.Speaker.Transitions)
+	require.False(t, pc.Speaker.On())
+}
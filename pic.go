@@ -0,0 +1,185 @@
+package cpu
+
+// PIC emulates the 8259A programmable interrupt controller of the PC: eight
+// edge triggered requests, IRQ0 of the timer is the highest priority one.
+// Special mask mode, polling and cascading aren't supported.
+type PIC struct {
+	// irr, isr and imr are the request, in-service and mask registers
+	irr, isr, imr uint8
+	// base is the type of the IRQ0 interrupt
+	base uint8
+	// lowest is the level of the lowest priority, the next one is the highest
+	lowest uint8
+	// autoEOI ends interrupts as they are acknowledged, rotating the
+	// priorities if rotateAEOI is set
+	autoEOI, rotateAEOI bool
+	// readISR selects the register port 20h reads: ISR or IRR
+	readISR bool
+
+	// icw is the number of the next initialization command word, 0 if the
+	// initialization is over. single and needICW4 are the bits of ICW1
+	icw              int
+	single, needICW4 bool
+}
+
+// PIC ports
+const (
+	picCommand = 0x20
+	picData    = 0x21
+)
+
+// picEOI is the non-specific EOI command
+const picEOI = 0x20
+
+// Install puts the PIC on ports 20h and 21h and makes it the interrupt
+// controller of the machine. It's initialized as the PC BIOS leaves it:
+// IRQ0-7 are interrupts 08h-0Fh and none of them is masked
+func (p *PIC) Install(m *Machine) {
+	*p = PIC{base: 0x08, lowest: 7}
+	m.AttachPorts(p, picCommand, picData)
+	m.PIC = p
+}
+
+// Raise requests the interrupt of the line on the rising edge of its signal
+func (p *PIC) Raise(irq int) {
+	p.irr |= 1 << irq
+}
+
+// Lower withdraws the request of the line if it isn't acknowledged yet
+func (p *PIC) Lower(irq int) {
+	p.irr &^= 1 << irq
+}
+
+// Acknowledge returns the type of the highest priority request which isn't
+// masked. Requests of the same or lower priority than an interrupt in
+// service wait for its EOI
+func (p *PIC) Acknowledge() (uint8, bool) {
+	if p.icw != 0 {
+		return 0, false
+	}
+
+	for i := range uint8(8) {
+		level := (p.lowest + 1 + i) % 8
+		bit := uint8(1) << level
+		if p.isr&bit != 0 {
+			return 0, false
+		}
+		if p.irr&bit == 0 || p.imr&bit != 0 {
+			continue
+		}
+
+		p.irr &^= bit
+		switch {
+		case !p.autoEOI:
+			p.isr |= bit
+		case p.rotateAEOI:
+			p.lowest = level
+		}
+		return p.base | level, true
+	}
+	return 0, false
+}
+
+func (p *PIC) In(port uint16) byte {
+	switch {
+	case port == picData:
+		return p.imr
+	case p.readISR:
+		return p.isr
+	default:
+		return p.irr
+	}
+}
+
+func (p *PIC) Out(port uint16, v byte) {
+	switch {
+	case port == picCommand && v&0x10 != 0:
+		p.initialize(v)
+	case port == picCommand && v&0x08 != 0:
+		// OCW3: the register to read
+		if v&0x02 != 0 {
+			p.readISR = v&0x01 != 0
+		}
+	case port == picCommand:
+		p.command(v)
+	case p.icw != 0:
+		p.initializeNext(v)
+	default:
+		// OCW1
+		p.imr = v
+	}
+}
+
+// initialize starts the initialization sequence with ICW1
+func (p *PIC) initialize(icw1 byte) {
+	*p = PIC{
+		base:     p.base,
+		lowest:   7,
+		icw:      2,
+		single:   icw1&0x02 != 0,
+		needICW4: icw1&0x01 != 0,
+	}
+}
+
+// initializeNext takes ICW2, ICW3 and ICW4 in turn. ICW3 comes only if
+// there are cascaded PICs, ICW4 — if ICW1 asks for it
+func (p *PIC) initializeNext(v byte) {
+	switch p.icw {
+	case 2:
+		p.base = v & 0xF8
+	case 3:
+		// NOTE: there is a single PIC, the cascade is ignored
+	case 4:
+		p.autoEOI = v&0x02 != 0
+	}
+
+	p.icw++
+	if p.icw == 3 && p.single {
+		p.icw++
+	}
+	if p.icw == 4 && !p.needICW4 || p.icw > 4 {
+		p.icw = 0
+	}
+}
+
+// command executes OCW2: EOIs and priority rotations
+func (p *PIC) command(ocw2 byte) {
+	level := ocw2 & 0x07
+
+	switch ocw2 >> 5 {
+	case 0b001:
+		// Non-specific EOI
+		p.eoi()
+	case 0b011:
+		// Specific EOI
+		p.isr &^= 1 << level
+	case 0b101:
+		// Rotate on non-specific EOI
+		if l, ok := p.eoi(); ok {
+			p.lowest = l
+		}
+	case 0b100:
+		p.rotateAEOI = true
+	case 0b000:
+		p.rotateAEOI = false
+	case 0b111:
+		// Rotate on specific EOI
+		p.isr &^= 1 << level
+		p.lowest = level
+	case 0b110:
+		// Set priority
+		p.lowest = level
+	}
+}
+
+// eoi ends the highest priority interrupt in service and returns its level
+func (p *PIC) eoi() (uint8, bool) {
+	for i := range uint8(8) {
+		level := (p.lowest + 1 + i) % 8
+		if bit := uint8(1) << level; p.isr&bit != 0 {
+			p.isr &^= bit
+			return level, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,97 @@
+package cpu_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	cpu "cpu8086"
+)
+
+func TestPICPriority(t *testing.T) {
+	var pic cpu.PIC
+	pic.Install(cpu.NewMachine())
+
+	pic.Raise(3)
+	pic.Raise(1)
+
+	n, ok := pic.Acknowledge()
+	require.True(t, ok)
+	require.Equal(t, uint8(0x09), n)
+
+	// NOTE: IRQ3 waits until IRQ1 is over
+	_, ok = pic.Acknowledge()
+	require.False(t, ok)
+	require.Equal(t, byte(0x08), pic.In(0x20))
+
+	pic.Out(0x20, 0x0B)
+	require.Equal(t, byte(0x02), pic.In(0x20))
+
+	pic.Out(0x20, 0x20)
+	n, ok = pic.Acknowledge()
+	require.True(t, ok)
+	require.Equal(t, uint8(0x0B), n)
+
+	// NOTE: a higher priority request nests
+	pic.Raise(0)
+	n, ok = pic.Acknowledge()
+	require.True(t, ok)
+	require.Equal(t, uint8(0x08), n)
+	require.Equal(t, byte(0x09), pic.In(0x20))
+
+	// NOTE: specific EOI of IRQ3 leaves IRQ0 in service
+	pic.Out(0x20, 0x63)
+	require.Equal(t, byte(0x01), pic.In(0x20))
+}
+
+func TestPICRotation(t *testing.T) {
+	var pic cpu.PIC
+	pic.Install(cpu.NewMachine())
+
+	// NOTE: IRQ3 is the lowest priority, so IRQ4 is the highest
+	pic.Out(0x20, 0xC3)
+	pic.Raise(0)
+	pic.Raise(5)
+
+	n, ok := pic.Acknowledge()
+	require.True(t, ok)
+	require.Equal(t, uint8(0x0D), n)
+
+	// NOTE: rotate on non-specific EOI makes IRQ5 the lowest
+	pic.Out(0x20, 0xA0)
+	pic.Raise(5)
+	n, ok = pic.Acknowledge()
+	require.True(t, ok)
+	require.Equal(t, uint8(0x08), n)
+}
+
+func TestPICInitialization(t *testing.T) {
+	var pic cpu.PIC
+	pic.Install(cpu.NewMachine())
+
+	// NOTE: single, ICW4 needed; IRQ0 is 50h; auto EOI; IRQ0 is masked
+	for _, w := range []struct {
+		port uint16
+		v    byte
+	}{{0x20, 0x13}, {0x21, 0x50}, {0x21, 0x03}, {0x21, 0x01}} {
+		pic.Out(w.port, w.v)
+	}
+	require.Equal(t, byte(0x01), pic.In(0x21))
+
+	pic.Raise(0)
+	pic.Raise(2)
+	n, ok := pic.Acknowledge()
+	require.True(t, ok)
+	require.Equal(t, uint8(0x52), n)
+
+	pic.Out(0x20, 0x0B)
+	require.Equal(t, byte(0x00), pic.In(0x20), "auto EOI")
+
+	_, ok = pic.Acknowledge()
+	require.False(t, ok, "IRQ0 is masked")
+
+	pic.Out(0x21, 0x00)
+	n, ok = pic.Acknowledge()
+	require.True(t, ok)
+	require.Equal(t, uint8(0x50), n)
+}
@@ -0,0 +1,311 @@
+package cpu
+
+// pitDivisor is the number of CPU clocks per PIT clock: the PIT runs at
+// 1.19 MHz, a quarter of the 4.77 MHz CPU clock
+const pitDivisor = 4
+
+// PIT ports
+const (
+	pitCounter0 = 0x40
+	pitControl  = 0x43
+)
+
+// PIT emulates the 8253 programmable interval timer of the PC in modes 0-3.
+// It counts with the executed clocks of the machine. Channel 0 raises IRQ0,
+// the gate of channel 2 is controlled by SetGate, the gates of the others
+// are always on.
+type PIT struct {
+	// PIC receives IRQ0 on the rising edge of the output of channel 0
+	PIC *PIC
+	// Speaker follows the output of channel 2
+	Speaker *Speaker
+
+	channels [3]pitChannel
+	// clocks are the machine clocks the counters are at
+	clocks int
+}
+
+// pitChannel is a counter of the PIT
+type pitChannel struct {
+	// mode is the counting mode: 0 — interrupt on terminal count, 1 — one-shot,
+	// 2 — rate generator, 3 — square wave
+	mode uint8
+	// access is how the count is read and written: 1 — the low byte, 2 — the
+	// high byte, 3 — the low byte and then the high one
+	access uint8
+	bcd    bool
+
+	// reload is the count written by the program, 0 means 65536 (10000 in BCD)
+	reload uint16
+	// count is the value of the counter
+	count uint16
+	// written is set if a count was written after the control word
+	written bool
+	// loading is set if the reload goes to the counter on the next clock
+	loading bool
+	// counting is set if a count has been loaded
+	counting bool
+	// half is the number of clocks left of the current half of a square wave
+	half int
+
+	out  bool
+	gate bool
+	// triggered is set if the gate went up, mode 1 starts over then
+	triggered bool
+
+	// writeHigh and readHigh are set if the next byte is the high one
+	writeHigh, readHigh bool
+	// low is the written low byte while the high one is pending
+	low byte
+	// latch is the count the counter latch command saved
+	latch   uint16
+	latched bool
+}
+
+// Install puts the PIT on ports 40h-43h and steps it with the machine. The
+// counters don't count until they are programmed
+func (p *PIT) Install(m *Machine) {
+	for i := range p.channels {
+		p.channels[i] = pitChannel{gate: i != 2, out: true}
+	}
+	p.clocks = m.Clocks
+	m.AttachPorts(p, pitCounter0, pitCounter0+1, pitCounter0+2, pitControl)
+	m.Devices = append(m.Devices, p)
+}
+
+// SetGate turns the gate input of the channel on or off
+func (p *PIT) SetGate(channel int, on bool) {
+	c := &p.channels[channel]
+	if on && !c.gate {
+		c.triggered = true
+	}
+	c.gate = on
+	if !on && (c.mode == 2 || c.mode == 3) {
+		// NOTE: a low gate stops the rate generator and the square wave high
+		p.setOut(channel, true)
+	}
+}
+
+// Output returns the output of the channel
+func (p *PIT) Output(channel int) bool {
+	return p.channels[channel].out
+}
+
+// Step counts the PIT clocks passed since the last step
+func (p *PIT) Step(m *Machine) {
+	for m.Clocks-p.clocks >= pitDivisor {
+		p.clocks += pitDivisor
+		for i := range p.channels {
+			p.tick(i)
+		}
+	}
+}
+
+func (p *PIT) In(port uint16) byte {
+	if port == pitControl {
+		// NOTE: the control register is write-only on the 8253
+		return 0xFF
+	}
+
+	c := &p.channels[port-pitCounter0]
+	v := c.value(c.count)
+	if c.latched {
+		v = c.latch
+	}
+
+	var b byte
+	switch {
+	case c.access == 1:
+		b = byte(v)
+	case c.access == 2:
+		b = byte(v >> 8)
+	case c.readHigh:
+		b = byte(v >> 8)
+	default:
+		b = byte(v)
+	}
+	if c.access == 3 {
+		c.readHigh = !c.readHigh
+	}
+	if !c.readHigh {
+		c.latched = false
+	}
+	return b
+}
+
+func (p *PIT) Out(port uint16, v byte) {
+	if port == pitControl {
+		p.control(v)
+		return
+	}
+
+	channel := int(port - pitCounter0)
+	c := &p.channels[channel]
+	switch {
+	case c.access == 1:
+		p.write(channel, uint16(v))
+	case c.access == 2:
+		p.write(channel, uint16(v)<<8)
+	case !c.writeHigh:
+		c.low = v
+		c.writeHigh = true
+	default:
+		c.writeHigh = false
+		p.write(channel, uint16(v)<<8|uint16(c.low))
+	}
+}
+
+// control takes the control word: the channel, the access, the mode and BCD
+func (p *PIT) control(v byte) {
+	channel := int(v >> 6)
+	if channel == 3 {
+		// NOTE: the read-back command is of the 8254
+		return
+	}
+
+	c := &p.channels[channel]
+	access := v >> 4 & 0b11
+	if access == 0 {
+		// Counter latch command
+		if !c.latched {
+			c.latch = c.value(c.count)
+			c.latched = true
+		}
+		return
+	}
+
+	// NOTE: modes 6 and 7 are aliases of 2 and 3
+	mode := v >> 1 & 0b111
+	if mode >= 6 {
+		mode -= 4
+	}
+	*c = pitChannel{mode: mode, access: access, bcd: v&0x01 != 0, gate: c.gate, out: c.out}
+	// NOTE: the output is low in mode 0 and high in the rest until counting starts
+	p.setOut(channel, mode != 0)
+}
+
+// write loads a count into the channel. Modes 2 and 3 take a new count at
+// the end of the current period, if they are already counting
+func (p *PIT) write(channel int, v uint16) {
+	c := &p.channels[channel]
+	c.reload = v
+	if c.bcd {
+		c.reload = fromBCD(v)
+	}
+	c.written = true
+
+	switch {
+	case c.mode == 0:
+		// NOTE: writing a count restarts mode 0 with the output low
+		c.loading = true
+		p.setOut(channel, false)
+	case c.mode == 1:
+		// NOTE: the one-shot waits for the gate
+	case !c.counting:
+		c.loading = true
+	}
+}
+
+// period returns the reload count, where 0 stands for the largest one
+func (c *pitChannel) period() int {
+	switch {
+	case c.reload != 0:
+		return int(c.reload)
+	case c.bcd:
+		return 10000
+	default:
+		return 0x10000
+	}
+}
+
+// value returns the count as the program reads it
+func (c *pitChannel) value(count uint16) uint16 {
+	if c.bcd {
+		return toBCD(count % 10000)
+	}
+	return count
+}
+
+// tick counts a PIT clock of the channel
+func (p *PIT) tick(channel int) {
+	c := &p.channels[channel]
+
+	if c.triggered {
+		// NOTE: the gate going up starts the one-shot over with the output
+		// low and restarts the periodic modes
+		c.triggered = false
+		if c.written && c.mode != 0 {
+			c.loading = true
+			if c.mode == 1 {
+				p.setOut(channel, false)
+			}
+		}
+	}
+
+	if c.loading {
+		c.loading = false
+		c.counting = true
+		c.count = uint16(c.period())
+		c.half = (c.period() + 1) / 2
+		return
+	}
+	if !c.counting || !c.gate && c.mode != 1 {
+		return
+	}
+
+	switch c.mode {
+	case 0, 1:
+		c.count--
+		if c.count == 0 {
+			p.setOut(channel, true)
+		}
+	case 2:
+		switch c.count {
+		case 1:
+			c.count = uint16(c.period())
+			p.setOut(channel, true)
+		default:
+			c.count--
+			if c.count == 1 {
+				p.setOut(channel, false)
+			}
+		}
+	case 3:
+		c.count -= 2
+		c.half--
+		if c.half > 0 {
+			return
+		}
+		// NOTE: an odd count is a clock longer high than low
+		c.count = uint16(c.period())
+		if c.out {
+			c.half = c.period() / 2
+		} else {
+			c.half = (c.period() + 1) / 2
+		}
+		p.setOut(channel, !c.out)
+	}
+}
+
+// setOut sets the output of the channel. Channel 0 raises IRQ0 as it goes
+// high, channel 2 drives the speaker
+func (p *PIT) setOut(channel int, out bool) {
+	c := &p.channels[channel]
+	if out && !c.out && channel == 0 && p.PIC != nil {
+		p.PIC.Raise(0)
+	}
+	if channel == 2 && p.Speaker != nil {
+		p.Speaker.setTimer(p.clocks, out)
+	}
+	c.out = out
+}
+
+// fromBCD converts a four digit BCD number to binary
+func fromBCD(v uint16) uint16 {
+	return v>>12&0xF*1000 + v>>8&0xF*100 + v>>4&0xF*10 + v&0xF
+}
+
+// toBCD converts a number below 10000 to four BCD digits
+func toBCD(v uint16) uint16 {
+	return v/1000<<12 | v/100%10<<8 | v/10%10<<4 | v%10
+}
@@ -0,0 +1,173 @@
+package cpu_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	cpu "cpu8086"
+)
+
+// advance steps the devices of the machine by the clocks
+func advance(m *cpu.Machine, clocks int) {
+	m.Clocks += clocks
+	for _, d := range m.Devices {
+		d.Step(m)
+	}
+}
+
+func TestPITInterrupts(t *testing.T) {
+	m := cpu.NewMachine()
+	cpu.NewPC(m)
+	n, err := runProgram(t, m, 0x1000, `
+mov word [8*4], timer
+mov al, 34h
+out 43h, al
+mov al, 100
+out 40h, al
+mov al, 0
+out 40h, al
+sti
+wait:
+hlt
+cmp word [ticks], 5
+jne wait
+cli
+hlt
+timer:
+add word [ticks], 1
+mov al, 20h
+out 20h, al
+iret
+ticks: dw 0
+`)
+	require.NoError(t, err)
+
+	ticks := 0x1000 + n - 2
+	require.Equal(t, []byte{5, 0}, m.Memory[ticks:ticks+2])
+	// NOTE: 100 PIT clocks between interrupts are 400 CPU clocks, the set-up
+	// and the entry and the handler of the last interrupt take about 200 more
+	require.InDelta(t, 5*400+200, m.Clocks, 100)
+}
+
+func TestPITUnhookedInterrupts(t *testing.T) {
+	for _, withBIOS := range []bool{false, true} {
+		m := cpu.NewMachine()
+		cpu.NewPC(m)
+		if withBIOS {
+			new(cpu.BIOS).Install(m)
+		}
+		n, err := runProgram(t, m, 0x1000, `
+mov al, 34h
+out 43h, al
+mov al, 100
+out 40h, al
+mov al, 0
+out 40h, al
+sti
+hlt
+hlt
+hlt
+cli
+hlt
+`)
+		require.NoError(t, err)
+
+		// NOTE: the vector of IRQ0 is zero, so each interrupt only ends
+		// the HLT and the next one comes as the EOI clears the ISR
+		require.Equal(t, uint16(0x1000+n), m.IP)
+		require.InDelta(t, 3*400, m.Clocks, 200)
+		pic := m.PIC.(*cpu.PIC)
+		pic.Out(0x20, 0x0B)
+		require.Equal(t, byte(0), pic.In(0x20))
+
+		if withBIOS {
+			require.Equal(t, []byte{3, 0, 0, 0}, m.Memory[0x46C:0x470])
+		}
+	}
+}
+
+func TestPITSquareWave(t *testing.T) {
+	m := cpu.NewMachine()
+	pit := cpu.NewPC(m).PIT
+
+	// NOTE: channel 0, the low byte only, mode 3
+	pit.Out(0x43, 0x16)
+	pit.Out(0x40, 5)
+
+	var outs []bool
+	for range 12 {
+		advance(m, 4)
+		outs = append(outs, pit.Output(0))
+	}
+	// NOTE: loaded on the first clock, then 3 clocks high and 2 low
+	require.Equal(t, []bool{
+		true, true, true, false, false,
+		true, true, true, false, false,
+		true, true,
+	}, outs)
+}
+
+func TestPITTerminalCount(t *testing.T) {
+	m := cpu.NewMachine()
+	pit := cpu.NewPC(m).PIT
+
+	// NOTE: channel 0, the low and the high bytes, mode 0
+	pit.Out(0x43, 0x30)
+	require.False(t, pit.Output(0))
+	pit.Out(0x40, 0x00)
+	pit.Out(0x40, 0x01)
+
+	advance(m, 4*11)
+
+	// NOTE: the latch keeps the count while the counter goes on
+	pit.Out(0x43, 0x00)
+	advance(m, 4*10)
+	require.Equal(t, byte(0xF6), pit.In(0x40))
+	require.Equal(t, byte(0x00), pit.In(0x40))
+	require.Equal(t, byte(0xEC), pit.In(0x40))
+
+	advance(m, 4*236)
+	require.True(t, pit.Output(0))
+
+	n, ok := m.PIC.Acknowledge()
+	require.True(t, ok)
+	require.Equal(t, uint8(0x08), n)
+}
+
+func TestPITOneShot(t *testing.T) {
+	m := cpu.NewMachine()
+	pit := cpu.NewPC(m).PIT
+
+	// NOTE: channel 2, the low byte only, mode 1, BCD
+	pit.Out(0x43, 0x93)
+	pit.Out(0x42, 0x12)
+	advance(m, 4*20)
+	require.True(t, pit.Output(2), "the gate is off")
+
+	pit.SetGate(2, true)
+	advance(m, 4)
+	require.False(t, pit.Output(2))
+	require.Equal(t, byte(0x12), pit.In(0x42))
+
+	advance(m, 4*11)
+	require.False(t, pit.Output(2))
+	advance(m, 4)
+	require.True(t, pit.Output(2))
+}
+
+func TestInOut(t *testing.T) {
+	m := cpu.NewMachine()
+	cpu.NewPC(m)
+	// NOTE: nothing is attached to the ports of COM1
+	_, err := runProgram(t, m, 0x1000, `
+mov al, 0ABh
+out 21h, al
+in al, 21h
+mov dx, 3F8h
+in ax, dx
+`)
+	require.NoError(t, err)
+	require.Equal(t, uint16(0xFFFF), m.Reg(cpu.AX))
+	require.Equal(t, byte(0xAB), m.PIC.(*cpu.PIC).In(0x21))
+}
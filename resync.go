@@ -0,0 +1,80 @@
+package cpu
+
+import "errors"
+
+// WithResync makes Disassemble continue past bytes it can't decode. Every
+// such byte is printed as "db" with the reason in a comment, decoding goes
+// on from the next byte, and skipped regions are summarized at the end:
+//
+//	db 0x0f ; unknown opcode
+//	...
+//	; skipped 1 region(s), 1 byte(s):
+//	;   0x0004-0x0004 (1 byte(s)): unknown opcode
+func WithResync() Option {
+	return func(o *options) {
+		o.resync = true
+	}
+}
+
+// skippedRegion is a run of bytes which couldn't be decoded
+type skippedRegion struct {
+	start, end int // end is inclusive
+	reason     DecodeReason
+}
+
+// skippedRegions merges adjacent undecodable bytes into regions
+func skippedRegions(insts []decodedInst) []skippedRegion {
+	var regions []skippedRegion
+	for _, d := range insts {
+		if d.bad == nil {
+			continue
+		}
+		if last := len(regions) - 1; last >= 0 && regions[last].end+1 == d.offset {
+			regions[last].end = d.offset
+			continue
+		}
+		regions = append(regions, skippedRegion{start: d.offset, end: d.offset, reason: d.bad.Reason})
+	}
+	return regions
+}
+
+func (p printer) printSkipped(regions []skippedRegion) {
+	if len(regions) == 0 {
+		return
+	}
+
+	var total int
+	for _, r := range regions {
+		total += r.end - r.start + 1
+	}
+
+	c := p.syntax.commentPrefix()
+	p.print("\n\n%s skipped %d region(s), %d byte(s):", c, len(regions), total)
+	for _, r := range regions {
+		p.print("\n%s   0x%04X-0x%04X (%d byte(s)): %s", c, r.start, r.end, r.end-r.start+1, r.reason)
+	}
+}
+
+// decodeResync is decodeAll which turns undecodable bytes into data
+func decodeResync(stream []byte, origin int) ([]decodedInst, error) {
+	var insts []decodedInst
+
+	for ip := 0; ip < len(stream); {
+		inst, r, n, err := decode(stream[ip:])
+
+		var decodeErr *DecodeError
+		switch {
+		case errors.As(err, &decodeErr):
+			decodeErr.Offset = origin + ip
+			insts = append(insts, decodedInst{offset: origin + ip, size: 1, raw: stream[ip : ip+1], data: dataBytes, bad: decodeErr})
+			ip++
+		case err != nil:
+			return insts, err
+		default:
+			insts = append(insts, decodedInst{offset: origin + ip, size: n, raw: stream[ip : ip+n], inst: inst, rule: r})
+			ip += n
+		}
+	}
+
+	return insts, nil
+}
@@ -0,0 +1,192 @@
+package cpu
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"strings"
+)
+
+// Adapter is a display adapter with a text framebuffer
+type Adapter uint8
+
+const (
+	// AdapterCGA has 16 colors, its framebuffer is at B800:0000
+	AdapterCGA Adapter = iota
+	// AdapterMDA is monochrome, its framebuffer is at B000:0000
+	AdapterMDA
+)
+
+// segment returns the segment of the framebuffer
+func (a Adapter) segment() uint16 {
+	if a == AdapterMDA {
+		return 0xB000
+	}
+	return 0xB800
+}
+
+// screenColumns is the width of the text screen Screen reads
+const screenColumns = 80
+
+// Cell is a character of a text screen with its attribute
+type Cell struct {
+	Char byte
+	Attr byte
+}
+
+// Screen is a snapshot of the first page of an 80x25 text screen
+type Screen struct {
+	Adapter Adapter
+	Cells   [screenRows][screenColumns]Cell
+}
+
+// Screen reads character and attribute pairs from the framebuffer of the adapter
+func (m *Machine) Screen(a Adapter) *Screen {
+	s := &Screen{Adapter: a}
+	base := uint32(a.segment()) << 4
+	for row := range s.Cells {
+		for col := range s.Cells[row] {
+			at := base + uint32(row*screenColumns+col)*2
+			s.Cells[row][col] = Cell{Char: m.Memory[at], Attr: m.Memory[at+1]}
+		}
+	}
+	return s
+}
+
+// String returns the characters of the screen, without trailing spaces and
+// empty lines at the end, so it's handy in golden tests
+func (s *Screen) String() string {
+	lines := make([]string, 0, screenRows)
+	for _, row := range s.Cells {
+		var b strings.Builder
+		for _, c := range row {
+			b.WriteRune(cp437[c.Char])
+		}
+		lines = append(lines, strings.TrimRight(b.String(), " "))
+	}
+	for len(lines) > 0 && lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return strings.Join(lines, "\n")
+}
+
+// cgaToANSI maps CGA colors to ANSI ones, which go in another order
+var cgaToANSI = [8]int{0, 4, 2, 6, 1, 5, 3, 7}
+
+// WriteANSI prints the screen as text with ANSI color escapes
+func (s *Screen) WriteANSI(w io.Writer) error {
+	var b strings.Builder
+	for _, row := range s.Cells {
+		last := -1
+		for _, c := range row {
+			if int(c.Attr) != last {
+				last = int(c.Attr)
+
+				fg, bg, underline := s.colors(c.Attr)
+				fgCode := 30 + cgaToANSI[fg&7]
+				if fg >= 8 {
+					fgCode += 60
+				}
+				fmt.Fprintf(&b, "\x1b[0;%d;%d", fgCode, 40+cgaToANSI[bg&7])
+				if underline {
+					b.WriteString(";4")
+				}
+				b.WriteString("m")
+			}
+			b.WriteRune(cp437[c.Char])
+		}
+		b.WriteString("\x1b[0m\n")
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// cgaPalette are the 16 colors of CGA text modes
+var cgaPalette = color.Palette{
+	color.RGBA{0x00, 0x00, 0x00, 0xFF},
+	color.RGBA{0x00, 0x00, 0xAA, 0xFF},
+	color.RGBA{0x00, 0xAA, 0x00, 0xFF},
+	color.RGBA{0x00, 0xAA, 0xAA, 0xFF},
+	color.RGBA{0xAA, 0x00, 0x00, 0xFF},
+	color.RGBA{0xAA, 0x00, 0xAA, 0xFF},
+	color.RGBA{0xAA, 0x55, 0x00, 0xFF},
+	color.RGBA{0xAA, 0xAA, 0xAA, 0xFF},
+	color.RGBA{0x55, 0x55, 0x55, 0xFF},
+	color.RGBA{0x55, 0x55, 0xFF, 0xFF},
+	color.RGBA{0x55, 0xFF, 0x55, 0xFF},
+	color.RGBA{0x55, 0xFF, 0xFF, 0xFF},
+	color.RGBA{0xFF, 0x55, 0x55, 0xFF},
+	color.RGBA{0xFF, 0x55, 0xFF, 0xFF},
+	color.RGBA{0xFF, 0xFF, 0x55, 0xFF},
+	color.RGBA{0xFF, 0xFF, 0xFF, 0xFF},
+}
+
+// colors returns the indexes of the foreground and background in cgaPalette.
+// CGA has the foreground in the low nibble and the background in bits 4-6,
+// blinking is ignored. MDA has normal, intense, reverse, underlined and
+// invisible characters.
+func (s *Screen) colors(attr byte) (fg, bg byte, underline bool) {
+	if s.Adapter == AdapterCGA {
+		return attr & 0x0F, attr >> 4 & 0x07, false
+	}
+
+	switch attr & 0x77 {
+	case 0x00:
+		return 0, 0, false
+	case 0x70:
+		return 0, 7, false
+	}
+	fg = 7
+	if attr&0x08 != 0 {
+		fg = 15
+	}
+	return fg, 0, attr&0x07 == 0x01
+}
+
+// Image draws the screen with the built-in font
+func (s *Screen) Image(font Font) *image.Paletted {
+	var (
+		h   = font.height()
+		img = image.NewPaletted(image.Rect(0, 0, screenColumns*8, screenRows*h), cgaPalette)
+	)
+
+	for row, cells := range s.Cells {
+		for col, c := range cells {
+			fg, bg, underline := s.colors(c.Attr)
+			for y := range h {
+				bits := font.row(c.Char, y)
+				if underline && y == h-1 {
+					bits = 0xFF
+				}
+				for x := range 8 {
+					px := bg
+					if bits&(0x80>>x) != 0 {
+						px = fg
+					}
+					img.SetColorIndex(col*8+x, row*h+y, px)
+				}
+			}
+		}
+	}
+	return img
+}
+
+// WritePNG encodes the image of the screen as PNG
+func (s *Screen) WritePNG(w io.Writer, font Font) error {
+	return png.Encode(w, s.Image(font))
+}
+
+// cp437 maps the characters of code page 437 to Unicode. Control codes are
+// the symbols the adapters display for them
+var cp437 = [256]rune([]rune("" +
+	" ☺☻♥♦♣♠•◘○◙♂♀♪♫☼►◄↕‼¶§▬↨↑↓→←∟↔▲▼" +
+	" !\"#$%&'()*+,-./0123456789:;<=>?" +
+	"@ABCDEFGHIJKLMNOPQRSTUVWXYZ[\\]^_" +
+	"`abcdefghijklmnopqrstuvwxyz{|}~⌂" +
+	"ÇüéâäàåçêëèïîìÄÅÉæÆôöòûùÿÖÜ¢£¥₧ƒ" +
+	"áíóúñÑªº¿⌐¬½¼¡«»░▒▓│┤╡╢╖╕╣║╗╝╜╛┐" +
+	"└┴┬├─┼╞╟╚╔╩╦╠═╬╧╨╤╥╙╘╒╓╫╪┘┌█▄▌▐▀" +
+	"αßΓπΣσµτΦΘΩδ∞φε∩≡±≥≤⌠⌡÷≈°∙·√ⁿ²■ "))
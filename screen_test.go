@@ -0,0 +1,123 @@
+package cpu_test
+
+import (
+	"bytes"
+	"image"
+	"image/png"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	cpu "cpu8086"
+	"cpu8086/asm"
+)
+
+func TestScreen(t *testing.T) {
+	sources, err := filepath.Glob("testdata/screen/*.asm")
+	require.NoError(t, err)
+	require.NotEmpty(t, sources)
+
+	for _, source := range sources {
+		name := strings.TrimSuffix(filepath.Base(source), ".asm")
+
+		t.Run(name, func(t *testing.T) {
+			src, err := os.ReadFile(source)
+			require.NoError(t, err)
+
+			program, err := asm.Assemble(string(src))
+			require.NoError(t, err)
+
+			m := cpu.NewMachine()
+			m.Load(program, 0)
+			new(cpu.BIOS).Install(m)
+			require.NoError(t, m.Run(1000))
+			got := m.Screen(cpu.AdapterCGA).String() + "\n"
+
+			goldenPath := strings.TrimSuffix(source, ".asm") + ".txt"
+			if *update {
+				require.NoError(t, os.WriteFile(goldenPath, []byte(got), 0o644))
+			}
+
+			want, err := os.ReadFile(goldenPath)
+			require.NoError(t, err)
+			require.Equal(t, string(want), got)
+		})
+	}
+}
+
+func TestScreenANSI(t *testing.T) {
+	m := cpu.NewMachine()
+	// NOTE: bright white on blue, then light gray on black
+	copy(m.Memory[0xB8000:], []byte{'H', 0x1F, 'i', 0x1F, 0xDB, 0x07})
+
+	var b strings.Builder
+	require.NoError(t, m.Screen(cpu.AdapterCGA).WriteANSI(&b))
+	first, _, _ := strings.Cut(b.String(), "\n")
+	require.Equal(t, "\x1b[0;97;44mHi\x1b[0;37;40m█\x1b[0;30;40m"+strings.Repeat(" ", 77)+"\x1b[0m", first)
+}
+
+func TestScreenMDA(t *testing.T) {
+	m := cpu.NewMachine()
+	copy(m.Memory[0xB0000:], []byte{'A', 0x70, 'B', 0x01})
+
+	s := m.Screen(cpu.AdapterMDA)
+	require.Equal(t, "AB", s.String())
+
+	img := s.Image(cpu.Font8x16)
+	require.Equal(t, 80*8, img.Bounds().Dx())
+	require.Equal(t, 25*16, img.Bounds().Dy())
+	// NOTE: reverse video has the light gray background, underline is the last line
+	require.Equal(t, uint8(7), img.ColorIndexAt(0, 0))
+	require.Equal(t, uint8(7), img.ColorIndexAt(8, 15))
+	require.Equal(t, uint8(0), img.ColorIndexAt(8, 14))
+}
+
+func TestScreenPNG(t *testing.T) {
+	m := cpu.NewMachine()
+	copy(m.Memory[0xB8000:], []byte{0xDB, 0x0E})
+
+	var b bytes.Buffer
+	require.NoError(t, m.Screen(cpu.AdapterCGA).WritePNG(&b, cpu.Font8x8))
+
+	img, err := png.Decode(&b)
+	require.NoError(t, err)
+	require.Equal(t, 80*8, img.Bounds().Dx())
+	require.Equal(t, 25*8, img.Bounds().Dy())
+
+	r, g, bl, _ := img.At(7, 7).RGBA()
+	require.Equal(t, [3]uint32{0xFFFF, 0xFFFF, 0x5555}, [3]uint32{r, g, bl})
+	r, g, bl, _ = img.At(8, 0).RGBA()
+	require.Equal(t, [3]uint32{0, 0, 0}, [3]uint32{r, g, bl})
+}
+
+func TestScreenFonts(t *testing.T) {
+	m := cpu.NewMachine()
+	copy(m.Memory[0xB8000:], []byte{0x01, 0x07, 0x82, 0x07, 0xE0, 0x07})
+	s := m.Screen(cpu.AdapterCGA)
+
+	// row reads 8 pixels of a line of the screen
+	row := func(img *image.Paletted, x, y int) byte {
+		var bits byte
+		for i := range 8 {
+			if img.ColorIndexAt(x+i, y) != 0 {
+				bits |= 0x80 >> i
+			}
+		}
+		return bits
+	}
+
+	// NOTE: the smiling face, "é" and "α" of the character generators
+	// of CGA and VGA
+	img := s.Image(cpu.Font8x8)
+	require.Equal(t, []byte{0x7E, 0x81, 0xA5}, []byte{row(img, 0, 0), row(img, 0, 1), row(img, 0, 2)})
+	require.Equal(t, []byte{0x1C, 0x00, 0x78}, []byte{row(img, 8, 0), row(img, 8, 1), row(img, 8, 2)})
+	require.Equal(t, []byte{0x76, 0xDC, 0xC8}, []byte{row(img, 16, 2), row(img, 16, 3), row(img, 16, 4)})
+
+	img = s.Image(cpu.Font8x16)
+	require.Equal(t, []byte{0x00, 0x7E, 0x81, 0xA5}, []byte{row(img, 0, 1), row(img, 0, 2), row(img, 0, 3), row(img, 0, 4)})
+	require.Equal(t, []byte{0x0C, 0x18, 0x30, 0x00}, []byte{row(img, 8, 1), row(img, 8, 2), row(img, 8, 3), row(img, 8, 4)})
+	require.Equal(t, []byte{0x76, 0xDC, 0xD8}, []byte{row(img, 16, 5), row(img, 16, 6), row(img, 16, 7)})
+}
@@ -0,0 +1,692 @@
+package cpu
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// MemorySize is the size of the 8086 address space: 1 MiB
+const MemorySize = 1 << 20
+
+// Flags is the flags register
+type Flags uint16
+
+const (
+	FlagCF Flags = 1 << 0
+	FlagPF Flags = 1 << 2
+	FlagAF Flags = 1 << 4
+	FlagZF Flags = 1 << 6
+	FlagSF Flags = 1 << 7
+	FlagTF Flags = 1 << 8
+	FlagIF Flags = 1 << 9
+	FlagDF Flags = 1 << 10
+	FlagOF Flags = 1 << 11
+)
+
+// NOTE: the order of flags the course prints them in
+var flagLetters = [...]struct {
+	flag   Flags
+	letter byte
+}{
+	{FlagCF, 'C'},
+	{FlagPF, 'P'},
+	{FlagAF, 'A'},
+	{FlagZF, 'Z'},
+	{FlagSF, 'S'},
+	{FlagTF, 'T'},
+	{FlagIF, 'I'},
+	{FlagDF, 'D'},
+	{FlagOF, 'O'},
+}
+
+// String returns letters of the set flags, e.g. "PZ"
+func (f Flags) String() string {
+	var b strings.Builder
+	for _, fl := range flagLetters {
+		if f&fl.flag != 0 {
+			b.WriteByte(fl.letter)
+		}
+	}
+	return b.String()
+}
+
+// Machine is the state of the simulated 8086
+type Machine struct {
+	// Regs are general registers in the REG field order: ax, cx, dx, bx, sp, bp, si, di
+	Regs [8]uint16
+	// SRegs are segment registers in the SR field order: es, cs, ss, ds
+	SRegs  [4]uint16
+	IP     uint16
+	Flags  Flags
+	Memory []byte
+
+	// Model selects clock timings of executed instructions
+	Model CPUModel
+	// Clocks is the estimated total of clocks of executed instructions
+	Clocks int
+	// lastClocks are clocks of the last executed instruction
+	lastClocks Clocks
+	// Bus enables the bus timing model if set. Model should be Model8088 then,
+	// so table clocks are comparable
+	Bus *BusTiming
+	// lastBusClocks are the clocks of the last executed instruction according to Bus
+	lastBusClocks int
+
+	// Devices are stepped after every instruction
+	Devices []Device
+	// Interrupts are interrupts handled in Go. INT n calls Interrupts[n] if
+	// it's set instead of the handler the interrupt vector table points to.
+	// Hardware interrupts call it only if the program hasn't set the vector
+	Interrupts map[uint8]InterruptHandler
+	// Ports are devices in the I/O address space. IN from a port without a
+	// device reads FFh, OUT to it is ignored
+	Ports map[uint16]IODevice
+	// PIC requests hardware interrupts. They are served after an instruction
+	// if IF is set
+	PIC InterruptController
+
+	// codeEnd is the offset in the code segment right after the loaded program
+	codeEnd int
+	halted  bool
+	// stopped is set if interrupts don't end HLT: Halt was called or HLT
+	// waited for an interrupt in vain
+	stopped bool
+}
+
+// InterruptHandler emulates an interrupt. The machine is right after the INT
+// instruction or the interrupted one, the handler returns to the code by returning
+type InterruptHandler func(m *Machine) error
+
+// Device is hardware which works alongside the CPU. Step is called after
+// every instruction, when Clocks already counts it
+type Device interface {
+	Step(m *Machine)
+}
+
+// IODevice is hardware on I/O ports. Word transfers are two byte ones: the
+// port and the next one
+type IODevice interface {
+	In(port uint16) byte
+	Out(port uint16, v byte)
+}
+
+// InterruptController requests hardware interrupts. Acknowledge returns the
+// type of the interrupt to serve, ok is false if there is no request
+type InterruptController interface {
+	Acknowledge() (n uint8, ok bool)
+}
+
+// ClockRate is the CPU clock of the PC in Hz: 4.77 MHz
+const ClockRate = 4_772_727
+
+// idleLimit is how long HLT waits for an interrupt: a second
+const idleLimit = ClockRate
+
+func NewMachine() *Machine {
+	return &Machine{Memory: make([]byte, MemorySize)}
+}
+
+// Load copies the program to CS:offset and points IP to its start
+func (m *Machine) Load(program []byte, offset uint16) {
+	for i, b := range program {
+		m.Memory[m.physical(CS, offset+uint16(i))] = b
+	}
+	m.start(offset, int(offset)+len(program))
+}
+
+// start prepares the machine to run a loaded program from ip up to codeEnd
+func (m *Machine) start(ip uint16, codeEnd int) {
+	m.IP = ip
+	m.codeEnd = codeEnd
+	m.halted = false
+	m.stopped = false
+}
+
+// Running reports whether the machine hasn't reached HLT or the end of the
+// program. HLT doesn't stop the machine if an interrupt could wake it up
+func (m *Machine) Running() bool {
+	return (!m.halted || m.canWake()) && int(m.IP) < m.codeEnd
+}
+
+// canWake reports whether a hardware interrupt could end HLT
+func (m *Machine) canWake() bool {
+	return m.PIC != nil && m.Flags&FlagIF != 0 && !m.stopped
+}
+
+// Halt stops the machine for good: unlike HLT, interrupts don't wake it up
+func (m *Machine) Halt() {
+	m.halted = true
+	m.stopped = true
+}
+
+// Reg returns a value of any register
+func (m *Machine) Reg(r Register) uint16 {
+	switch {
+	case r >= AL && r <= BL:
+		return m.Regs[r-AL] & 0xFF
+	case r >= AH && r <= BH:
+		return m.Regs[r-AH] >> 8
+	case r >= AX && r <= DI:
+		return m.Regs[r-AX]
+	case r >= ES && r <= DS:
+		return m.SRegs[r-ES]
+	default:
+		panic(fmt.Sprintf("invalid register: %d", r))
+	}
+}
+
+// SetReg sets a value of any register. Only the low byte of v is used for 8-bit registers
+func (m *Machine) SetReg(r Register, v uint16) {
+	switch {
+	case r >= AL && r <= BL:
+		m.Regs[r-AL] = m.Regs[r-AL]&0xFF00 | v&0xFF
+	case r >= AH && r <= BH:
+		m.Regs[r-AH] = m.Regs[r-AH]&0x00FF | v<<8
+	case r >= AX && r <= DI:
+		m.Regs[r-AX] = v
+	case r >= ES && r <= DS:
+		m.SRegs[r-ES] = v
+	default:
+		panic(fmt.Sprintf("invalid register: %d", r))
+	}
+}
+
+// NOTE: the order of registers the course prints them in
+var dumpOrder = [...]Register{AX, BX, CX, DX, SP, BP, SI, DI, ES, CS, SS, DS}
+
+// DumpRegisters prints non-zero registers, IP and flags one per line, names
+// right-aligned: "      bx: 0x03e8 (1000)", "   flags: PZ"
+func (m *Machine) DumpRegisters(w io.Writer) error {
+	for _, r := range dumpOrder {
+		if v := m.Reg(r); v != 0 {
+			if _, err := fmt.Fprintf(w, "%8s: 0x%04x (%d)\n", r, v, v); err != nil {
+				return err
+			}
+		}
+	}
+
+	if _, err := fmt.Fprintf(w, "%8s: 0x%04x (%d)\n", "ip", m.IP, m.IP); err != nil {
+		return err
+	}
+
+	if m.Flags != 0 {
+		if _, err := fmt.Fprintf(w, "%8s: %s\n", "flags", m.Flags); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Step decodes and executes the instruction at CS:IP. After HLT it waits for
+// a hardware interrupt instead and returns HLT
+func (m *Machine) Step() (Instruction, error) {
+	inst, _, err := m.step()
+	return inst, err
+}
+
+// step is Step which also returns the decoding rule of the instruction
+func (m *Machine) step() (Instruction, Rule, error) {
+	if m.halted {
+		return Instruction{mnemonic: HLT}, Rule{}, m.idle()
+	}
+
+	ip := m.IP
+
+	inst, r, n, err := decode(m.Memory[m.physical(CS, ip):])
+	if err != nil {
+		return inst, r, withOffset(err, int(ip))
+	}
+
+	// NOTE: effective addresses are taken before the instruction changes registers
+	odd := m.oddTransfer(inst)
+
+	m.IP += uint16(n)
+	taken, err := m.exec(inst, r)
+	if err != nil {
+		return inst, r, fmt.Errorf("offset 0x%X: %s: %w", ip, inst, err)
+	}
+
+	m.lastClocks = estimateClocks(inst, r, m.Model, taken, odd)
+	m.Clocks += m.lastClocks.Total()
+
+	if m.Bus != nil {
+		m.lastBusClocks = m.Bus.step(n, m.lastClocks, r.JMP, taken)
+	}
+
+	for _, d := range m.Devices {
+		d.Step(m)
+	}
+
+	if _, err := m.acknowledge(); err != nil {
+		return inst, r, fmt.Errorf("offset 0x%X: %w", m.IP, err)
+	}
+
+	return inst, r, nil
+}
+
+// acknowledge serves the hardware interrupt the PIC requests, if IF is set
+func (m *Machine) acknowledge() (bool, error) {
+	if m.PIC == nil || m.Flags&FlagIF == 0 {
+		return false, nil
+	}
+	n, ok := m.PIC.Acknowledge()
+	if !ok {
+		return false, nil
+	}
+	m.halted = false
+	m.countInterrupt()
+
+	// NOTE: a program hooks a hardware interrupt by setting its vector, Go
+	// handlers serve the rest as the BIOS would
+	vector := uint32(n) * 4
+	hooked := m.readWord(vector) != 0 || m.readWord(vector+2) != 0
+	handler, ok := m.Interrupts[n]
+	switch {
+	case hooked:
+		m.enter(n)
+	case ok:
+		return true, handler(m)
+	default:
+		// NOTE: there is no vector to jump to, so the interrupt only ends
+		// as the default handler of the BIOS would end it
+		m.out(picCommand, picEOI)
+	}
+	return true, nil
+}
+
+// interruptClocks is the time of the INTR sequence: two acknowledge bus
+// cycles, pushes of flags, CS and IP and reads of the vector
+const interruptClocks = 61
+
+// countInterrupt adds the clocks of the INTR sequence to the last step. The
+// sequence is the same whatever serves the interrupt
+func (m *Machine) countInterrupt() {
+	c := Clocks{Base: interruptClocks, reads: 2, writes: 3, word: true}.withPenalty(m.Model, false)
+	m.Clocks += c.Total()
+	m.lastClocks.Base += c.Base
+	m.lastClocks.Penalty += c.Penalty
+	if m.Bus != nil {
+		m.lastBusClocks += m.Bus.step(0, c, false, true)
+	}
+}
+
+// idle lets the time go on after HLT until a hardware interrupt comes. The
+// machine stays halted for good if it doesn't come within idleLimit
+func (m *Machine) idle() error {
+	start := m.Clocks
+	m.lastClocks = Clocks{}
+	m.lastBusClocks = 0
+	defer func() {
+		m.lastClocks = Clocks{Base: m.Clocks - start}
+	}()
+
+	for m.Clocks-start < idleLimit {
+		// NOTE: the 8086 checks for interrupts every bus cycle while halted,
+		// the BIU fills the queue meanwhile
+		m.Clocks += busCycleClocks
+		if m.Bus != nil {
+			m.lastBusClocks += m.Bus.idle(busCycleClocks)
+		}
+		for _, d := range m.Devices {
+			d.Step(m)
+		}
+		if served, err := m.acknowledge(); served || err != nil {
+			return err
+		}
+	}
+	m.stopped = true
+	return nil
+}
+
+// oddTransfer reports whether the instruction accesses memory at an odd address
+func (m *Machine) oddTransfer(inst Instruction) bool {
+	for _, o := range [...]Operand{inst.dst, inst.src} {
+		if o.kind == opKindEAC && m.address(o)%2 != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// ErrStepLimit is returned by Run and Trace when they have executed the
+// maximum of steps and the machine is still running
+var ErrStepLimit = errors.New("the limit of steps is reached")
+
+// Run executes instructions while the machine is running. maxSteps limits
+// the number of executed instructions if positive
+func (m *Machine) Run(maxSteps int) error {
+	return m.run(maxSteps, nil)
+}
+
+// run executes instructions calling after for each one with the state before
+// it and its decoding rule
+func (m *Machine) run(maxSteps int, after func(before *Machine, inst Instruction, r Rule) error) error {
+	for steps := 0; m.Running(); steps++ {
+		if maxSteps > 0 && steps == maxSteps {
+			return fmt.Errorf("%w: %d", ErrStepLimit, maxSteps)
+		}
+
+		before := *m
+		inst, r, err := m.step()
+		if err != nil {
+			return err
+		}
+
+		if after != nil {
+			if err := after(&before, inst, r); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// exec executes a decoded instruction and reports whether it transferred
+// control: a taken jump, RET, IRET or INT which isn't handled in Go
+func (m *Machine) exec(inst Instruction, r Rule) (bool, error) {
+	switch {
+	case r.JMP:
+		if m.jumpTaken(inst.mnemonic) {
+			m.IP += uint16(int16(inst.jump))
+			return true, nil
+		}
+	case inst.mnemonic == HLT:
+		m.halted = true
+	case inst.mnemonic == RET:
+		m.IP = m.pop()
+		return true, nil
+	case inst.mnemonic == INT:
+		return m.interrupt(uint8(inst.dst.imm.val))
+	case inst.mnemonic == IRET:
+		m.IP = m.pop()
+		m.SetReg(CS, m.pop())
+		m.Flags = Flags(m.pop())
+		return true, nil
+	case inst.mnemonic == CLI:
+		m.setFlag(FlagIF, false)
+	case inst.mnemonic == STI:
+		m.setFlag(FlagIF, true)
+	case inst.mnemonic == IN:
+		port := m.port(inst.src)
+		v := uint16(m.in(port))
+		if inst.width() == 16 {
+			v |= uint16(m.in(port+1)) << 8
+		}
+		m.SetReg(inst.dst.reg, v)
+	case inst.mnemonic == OUT:
+		port, v := m.port(inst.dst), m.Reg(inst.src.reg)
+		m.out(port, byte(v))
+		if inst.width() == 16 {
+			m.out(port+1, byte(v>>8))
+		}
+	default:
+		word := inst.width() == 16
+		src := m.read(inst.src, word)
+
+		switch inst.mnemonic {
+		case MOV:
+			m.write(inst.dst, word, src)
+		case ADD, SUB:
+			m.write(inst.dst, word, m.arith(inst.mnemonic, m.read(inst.dst, word), src, word))
+		case CMP:
+			m.arith(inst.mnemonic, m.read(inst.dst, word), src, word)
+		default:
+			panic(fmt.Sprintf("unsupported instruction: %s", inst.mnemonic))
+		}
+	}
+	return false, nil
+}
+
+// interrupt calls the Go handler of the interrupt if there is one. Otherwise
+// it enters the handler the vector table points to
+func (m *Machine) interrupt(n uint8) (bool, error) {
+	if handler, ok := m.Interrupts[n]; ok {
+		return false, handler(m)
+	}
+	m.enter(n)
+	return true, nil
+}
+
+// enter pushes flags, CS and IP and jumps to the vector from 0000:(4 * n)
+func (m *Machine) enter(n uint8) {
+	m.push(uint16(m.Flags))
+	m.push(m.Reg(CS))
+	m.push(m.IP)
+	m.setFlag(FlagIF, false)
+	m.setFlag(FlagTF, false)
+
+	vector := uint32(n) * 4
+	m.IP = m.readWord(vector)
+	m.SetReg(CS, m.readWord(vector+2))
+}
+
+// port returns the port number of IN and OUT: the immediate byte or DX
+func (m *Machine) port(o Operand) uint16 {
+	if o.kind == opKindReg {
+		return m.Reg(DX)
+	}
+	return uint16(o.imm.val)
+}
+
+func (m *Machine) in(port uint16) byte {
+	if d, ok := m.Ports[port]; ok {
+		return d.In(port)
+	}
+	return 0xFF
+}
+
+func (m *Machine) out(port uint16, v byte) {
+	if d, ok := m.Ports[port]; ok {
+		d.Out(port, v)
+	}
+}
+
+// AttachPorts puts the device on the ports
+func (m *Machine) AttachPorts(d IODevice, ports ...uint16) {
+	if m.Ports == nil {
+		m.Ports = make(map[uint16]IODevice)
+	}
+	for _, p := range ports {
+		m.Ports[p] = d
+	}
+}
+
+func (m *Machine) push(v uint16) {
+	sp := m.Reg(SP) - 2
+	m.SetReg(SP, sp)
+	m.writeWord(m.physical(SS, sp), v)
+}
+
+func (m *Machine) pop() uint16 {
+	sp := m.Reg(SP)
+	m.SetReg(SP, sp+2)
+	return m.readWord(m.physical(SS, sp))
+}
+
+func (m *Machine) jumpTaken(mnemonic Mnemonic) bool {
+	var (
+		cf = m.Flags&FlagCF != 0
+		pf = m.Flags&FlagPF != 0
+		zf = m.Flags&FlagZF != 0
+		sf = m.Flags&FlagSF != 0
+		of = m.Flags&FlagOF != 0
+	)
+
+	switch mnemonic {
+	case JE:
+		return zf
+	case JNE, JNZ:
+		return !zf
+	case JL:
+		return sf != of
+	case JNL:
+		return sf == of
+	case JLE:
+		return zf || sf != of
+	case JG:
+		return !zf && sf == of
+	case JB:
+		return cf
+	case JNB:
+		return !cf
+	case JBE:
+		return cf || zf
+	case JA:
+		return !cf && !zf
+	case JP:
+		return pf
+	case JNP:
+		return !pf
+	case JO:
+		return of
+	case JNO:
+		return !of
+	case JS:
+		return sf
+	case JNS:
+		return !sf
+	case LOOP, LOOPZ, LOOPNZ:
+		cx := m.Reg(CX) - 1
+		m.SetReg(CX, cx)
+		switch mnemonic {
+		case LOOPZ:
+			return cx != 0 && zf
+		case LOOPNZ:
+			return cx != 0 && !zf
+		default:
+			return cx != 0
+		}
+	case JCXZ:
+		return m.Reg(CX) == 0
+	case JMP:
+		return true
+	default:
+		panic(fmt.Sprintf("unsupported jump: %s", mnemonic))
+	}
+}
+
+// arith computes ADD, SUB and CMP, sets flags and returns the result
+func (m *Machine) arith(mnemonic Mnemonic, a, b uint16, word bool) uint16 {
+	var mask, sign uint32 = 0xFF, 0x80
+	if word {
+		mask, sign = 0xFFFF, 0x8000
+	}
+
+	var (
+		x, y   = uint32(a) & mask, uint32(b) & mask
+		res    uint32
+		cf, af bool
+		of     bool
+	)
+
+	switch mnemonic {
+	case ADD:
+		res = x + y
+		cf = res > mask
+		af = x&0xF+y&0xF > 0xF
+		of = (x^res)&(y^res)&sign != 0
+	case SUB, CMP:
+		res = x - y
+		cf = y > x
+		af = y&0xF > x&0xF
+		of = (x^y)&(x^res)&sign != 0
+	}
+	res &= mask
+
+	m.setFlag(FlagCF, cf)
+	m.setFlag(FlagAF, af)
+	m.setFlag(FlagOF, of)
+	m.setFlag(FlagZF, res == 0)
+	m.setFlag(FlagSF, res&sign != 0)
+	m.setFlag(FlagPF, parity(byte(res)))
+
+	return uint16(res)
+}
+
+func (m *Machine) setFlag(f Flags, on bool) {
+	if on {
+		m.Flags |= f
+	} else {
+		m.Flags &^= f
+	}
+}
+
+// parity reports whether the number of set bits is even
+func parity(b byte) bool {
+	b ^= b >> 4
+	b ^= b >> 2
+	b ^= b >> 1
+	return b&1 == 0
+}
+
+func (m *Machine) read(o Operand, word bool) uint16 {
+	switch o.kind {
+	case opKindReg:
+		return m.Reg(o.reg)
+	case opKindImm:
+		if word {
+			return uint16(o.imm.val)
+		}
+		return uint16(o.imm.val) & 0xFF
+	case opKindEAC:
+		addr := m.address(o)
+		if word {
+			return uint16(m.Memory[addr]) | uint16(m.Memory[(addr+1)%MemorySize])<<8
+		}
+		return uint16(m.Memory[addr])
+	default:
+		panic(fmt.Sprintf("unsupported operand kind: %d", o.kind))
+	}
+}
+
+func (m *Machine) write(o Operand, word bool, v uint16) {
+	switch o.kind {
+	case opKindReg:
+		m.SetReg(o.reg, v)
+	case opKindEAC:
+		addr := m.address(o)
+		m.Memory[addr] = byte(v)
+		if word {
+			m.Memory[(addr+1)%MemorySize] = byte(v >> 8)
+		}
+	default:
+		panic(fmt.Sprintf("can't write to operand kind: %d", o.kind))
+	}
+}
+
+// address returns the physical address of a memory operand. Addresses
+// based on BP are in the stack segment, the rest — in the data segment
+func (m *Machine) address(o Operand) uint32 {
+	var (
+		ea  = uint16(o.eac.dispOrDA)
+		seg = DS
+	)
+
+	if o.eac.form != 0b000 {
+		ea += m.Reg(o.eac.reg1)
+		if o.eac.reg2 != registerInvalid {
+			ea += m.Reg(o.eac.reg2)
+		}
+		if o.eac.reg1 == BP {
+			seg = SS
+		}
+	}
+
+	return m.physical(seg, ea)
+}
+
+func (m *Machine) readWord(addr uint32) uint16 {
+	return uint16(m.Memory[addr%MemorySize]) | uint16(m.Memory[(addr+1)%MemorySize])<<8
+}
+
+func (m *Machine) writeWord(addr uint32, v uint16) {
+	m.Memory[addr%MemorySize] = byte(v)
+	m.Memory[(addr+1)%MemorySize] = byte(v >> 8)
+}
+
+func (m *Machine) physical(seg Register, offset uint16) uint32 {
+	return (uint32(m.Reg(seg))<<4 + uint32(offset)) % MemorySize
+}
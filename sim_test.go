@@ -0,0 +1,153 @@
+package cpu_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	cpu "cpu8086"
+	"cpu8086/asm"
+)
+
+func TestMachineRun(t *testing.T) {
+	tests := []struct {
+		name  string
+		src   string
+		regs  map[cpu.Register]uint16
+		flags cpu.Flags
+	}{
+		{
+			name: "movs",
+			src: `
+mov ax, 1
+mov bx, 2
+mov cl, 3
+mov ch, 4
+mov dx, cx
+mov [1000], dx
+mov si, [1000]
+mov al, [1001]
+`,
+			regs: map[cpu.Register]uint16{cpu.AX: 4, cpu.BX: 2, cpu.CX: 0x0403, cpu.DX: 0x0403, cpu.SI: 0x0403},
+		},
+		{
+			name: "add sub cmp",
+			src: `
+mov bx, -4093
+mov cx, 3841
+sub bx, cx
+mov sp, 998
+mov bp, 999
+cmp bp, sp
+add bp, 1027
+sub bp, 2026
+`,
+			regs:  map[cpu.Register]uint16{cpu.BX: 0xe102, cpu.CX: 0x0f01, cpu.SP: 0x03e6},
+			flags: cpu.FlagPF | cpu.FlagZF,
+		},
+		{
+			name: "loop",
+			src: `
+mov cx, 5
+mov ax, 0
+again:
+add ax, 3
+loop again
+`,
+			regs:  map[cpu.Register]uint16{cpu.AX: 15},
+			flags: cpu.FlagPF,
+		},
+		{
+			name: "jmp",
+			src: `
+mov ax, 1
+jmp short over
+mov ax, 2
+over:
+mov bx, 3
+`,
+			regs: map[cpu.Register]uint16{cpu.AX: 1, cpu.BX: 3},
+		},
+		{
+			name: "memory via bp and bx",
+			src: `
+mov bp, 100
+mov word [bp + 4], 7
+mov bx, 104
+add [bx], byte 1
+mov di, [bp + 4]
+cmp di, 9
+hlt
+mov di, 0
+`,
+			regs:  map[cpu.Register]uint16{cpu.BP: 100, cpu.BX: 104, cpu.DI: 8},
+			flags: cpu.FlagCF | cpu.FlagPF | cpu.FlagAF | cpu.FlagSF,
+		},
+		{
+			name: "int through the vector table and ret",
+			src: `
+mov word [0x60 * 4], handler
+mov word [0x60 * 4 + 2], 0
+mov sp, 0x1000
+int 0x60
+mov bx, 2
+hlt
+handler:
+mov ax, 1
+ret
+`,
+			// NOTE: RET leaves CS and flags which INT pushed on the stack
+			regs: map[cpu.Register]uint16{cpu.AX: 1, cpu.BX: 2, cpu.SP: 0x0ffc},
+		},
+		{
+			name: "int and iret",
+			src: `
+mov word [0x60 * 4], handler
+mov word [0x60 * 4 + 2], 0
+mov sp, 0x1000
+int 0x60
+mov bx, 2
+hlt
+handler:
+sti
+mov ax, 1
+iret
+`,
+			// NOTE: IRET restores IF, which STI set in the handler
+			regs: map[cpu.Register]uint16{cpu.AX: 1, cpu.BX: 2, cpu.SP: 0x1000},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			program, err := asm.Assemble(tt.src)
+			require.NoError(t, err)
+
+			m := cpu.NewMachine()
+			m.Load(program, 0)
+			require.NoError(t, m.Run(1000))
+
+			for _, r := range []cpu.Register{cpu.AX, cpu.BX, cpu.CX, cpu.DX, cpu.SP, cpu.BP, cpu.SI, cpu.DI} {
+				require.Equal(t, tt.regs[r], m.Reg(r), "register %s", r)
+			}
+			require.Equal(t, tt.flags.String(), m.Flags.String())
+		})
+	}
+}
+
+func TestMachineRegisters(t *testing.T) {
+	m := cpu.NewMachine()
+
+	m.SetReg(cpu.AX, 0x1234)
+	m.SetReg(cpu.AH, 0xff)
+	require.Equal(t, uint16(0xff34), m.Reg(cpu.AX))
+	require.Equal(t, uint16(0x34), m.Reg(cpu.AL))
+
+	m.SetReg(cpu.DS, 0x1000)
+	require.Equal(t, uint16(0x1000), m.Reg(cpu.DS))
+
+	var b strings.Builder
+	require.NoError(t, m.DumpRegisters(&b))
+	require.Equal(t, "      ax: 0xff34 (65332)\n      ds: 0x1000 (4096)\n      ip: 0x0000 (0)\n", b.String())
+}
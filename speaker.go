@@ -0,0 +1,118 @@
+package cpu
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// Speaker records the PC speaker. It's on while bit 1 of port 61h is set and
+// the output of PIT channel 2 is high, so a program either plays a tone with
+// the timer or toggles the bit itself with the gate of the timer off.
+type Speaker struct {
+	// Transitions are the times the speaker turned on and off, in order
+	Transitions []SpeakerTransition
+
+	// data is bit 1 of port 61h, timerLow is set if the output of PIT
+	// channel 2 is low. It's high until the channel is programmed
+	data, timerLow bool
+}
+
+// SpeakerTransition is a turn of the speaker on or off at the machine clocks
+type SpeakerTransition struct {
+	Clocks int
+	On     bool
+}
+
+// On reports whether the speaker is on
+func (s *Speaker) On() bool {
+	return s.data && !s.timerLow
+}
+
+func (s *Speaker) setData(clocks int, on bool) {
+	s.update(clocks, func() { s.data = on })
+}
+
+func (s *Speaker) setTimer(clocks int, out bool) {
+	s.update(clocks, func() { s.timerLow = !out })
+}
+
+// update changes an input and records the transition if there is one
+func (s *Speaker) update(clocks int, change func()) {
+	was := s.On()
+	change()
+	if s.On() == was {
+		return
+	}
+
+	// NOTE: the PIT lags behind the CPU by less than its clock, so the
+	// inputs could change a few clocks out of order
+	if n := len(s.Transitions); n > 0 {
+		clocks = max(clocks, s.Transitions[n-1].Clocks)
+	}
+	s.Transitions = append(s.Transitions, SpeakerTransition{clocks, s.On()})
+}
+
+// speakerAmplitude is the sample of the speaker being on, off is silence
+const speakerAmplitude = 0x3FFF
+
+// WriteWAV renders the sound of the speaker from the start to the clocks as
+// a 16-bit mono PCM WAV file. A sample is the share of its time the speaker
+// is on, which smooths tones above the Nyquist frequency
+func (s *Speaker) WriteWAV(w io.Writer, sampleRate, clocks int) error {
+	if sampleRate <= 0 {
+		return fmt.Errorf("invalid sample rate: %d", sampleRate)
+	}
+
+	var (
+		samples = int(int64(clocks) * int64(sampleRate) / ClockRate)
+		data    = make([]byte, 0, samples*2)
+		// on is the state of the speaker since the clock from, next is the
+		// index of the transition which follows
+		on    bool
+		from  int
+		next  int
+		start int
+	)
+	for i := range samples {
+		end := int(int64(i+1) * ClockRate / int64(sampleRate))
+
+		var onClocks int
+		for next < len(s.Transitions) && s.Transitions[next].Clocks < end {
+			t := s.Transitions[next]
+			if on {
+				onClocks += t.Clocks - from
+			}
+			from, on = t.Clocks, t.On
+			next++
+		}
+		if on {
+			onClocks += end - from
+		}
+		from = end
+
+		v := speakerAmplitude * onClocks / max(end-start, 1)
+		data = binary.LittleEndian.AppendUint16(data, uint16(v))
+		start = end
+	}
+
+	header := make([]byte, 0, 44)
+	header = append(header, "RIFF"...)
+	header = binary.LittleEndian.AppendUint32(header, uint32(36+len(data)))
+	header = append(header, "WAVEfmt "...)
+	header = binary.LittleEndian.AppendUint32(header, 16)
+	header = binary.LittleEndian.AppendUint16(header, 1) // PCM
+	header = binary.LittleEndian.AppendUint16(header, 1) // mono
+	header = binary.LittleEndian.AppendUint32(header, uint32(sampleRate))
+	header = binary.LittleEndian.AppendUint32(header, uint32(sampleRate*2))
+	header = binary.LittleEndian.AppendUint16(header, 2)
+	header = binary.LittleEndian.AppendUint16(header, 16)
+	header = append(header, "data"...)
+	header = binary.LittleEndian.AppendUint32(header, uint32(len(data)))
+
+	if _, err := w.Write(header); err != nil {
+		return err
+	}
+	_, err := w.Write(data)
+	return err
+}
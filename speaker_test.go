@@ -0,0 +1,138 @@
+package cpu_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	cpu "cpu8086"
+)
+
+func TestSpeakerTone(t *testing.T) {
+	m := cpu.NewMachine()
+	speaker := cpu.NewPC(m).Speaker
+
+	_, err := runProgram(t, m, 0x1000, `
+mov al, 0xb6
+out 43h, al
+mov al, 100
+out 42h, al
+mov al, 0
+out 42h, al
+mov al, 3
+out 61h, al
+mov cx, 200
+wait:
+loop wait
+mov al, 0
+out 61h, al
+cli
+hlt
+`)
+	require.NoError(t, err)
+	transitions := speaker.Transitions
+	require.Greater(t, len(transitions), 10)
+	require.False(t, speaker.On())
+
+	// NOTE: the speaker turns on with the data bit as the output is high,
+	// then follows the square wave of 50 PIT clocks a half
+	require.True(t, transitions[0].On)
+	for i, tr := range transitions[1 : len(transitions)-1] {
+		require.Equal(t, i%2 == 1, tr.On)
+		if i > 0 {
+			require.Equal(t, 50*4, tr.Clocks-transitions[i].Clocks)
+		}
+	}
+	require.False(t, transitions[len(transitions)-1].On)
+}
+
+func TestSpeakerModeZeroReload(t *testing.T) {
+	m := cpu.NewMachine()
+	pc := cpu.NewPC(m)
+
+	// NOTE: channel 2, the low and the high bytes, mode 0
+	pc.PIT.Out(0x43, 0xB0)
+	pc.Keyboard.Out(0x61, 3)
+	pc.PIT.Out(0x42, 10)
+	pc.PIT.Out(0x42, 0)
+	advance(m, 4*12)
+	require.True(t, pc.Speaker.On())
+
+	// NOTE: a new count drops the output at once
+	pc.PIT.Out(0x42, 10)
+	pc.PIT.Out(0x42, 0)
+	require.False(t, pc.Speaker.On())
+	require.Equal(t, []bool{true, false}, speakerStates(pc.Speaker.Transitions))
+}
+
+func speakerStates(transitions []cpu.SpeakerTransition) []bool {
+	var states []bool
+	for _, tr := range transitions {
+		states = append(states, tr.On)
+	}
+	return states
+}
+
+func TestSpeakerWAV(t *testing.T) {
+	speaker := cpu.Speaker{Transitions: []cpu.SpeakerTransition{
+		{Clocks: cpu.ClockRate * 3 / 16, On: true},
+		{Clocks: cpu.ClockRate * 13 / 16, On: false},
+	}}
+
+	var b bytes.Buffer
+	require.NoError(t, speaker.WriteWAV(&b, 8, cpu.ClockRate))
+	data := b.Bytes()
+	require.Len(t, data, 44+8*2)
+	require.Equal(t, "RIFF", string(data[0:4]))
+	require.Equal(t, uint32(36+16), binary.LittleEndian.Uint32(data[4:]))
+	require.Equal(t, "WAVEfmt ", string(data[8:16]))
+	require.Equal(t, uint32(8), binary.LittleEndian.Uint32(data[24:]))
+	require.Equal(t, "data", string(data[36:40]))
+
+	var samples []uint16
+	for i := 44; i < len(data); i += 2 {
+		samples = append(samples, binary.LittleEndian.Uint16(data[i:]))
+	}
+	// NOTE: the speaker turns on and off in the middle of the samples 1 and 6
+	require.Equal(t, []uint16{0, 0x1FFF, 0x3FFF, 0x3FFF, 0x3FFF, 0x3FFF, 0x1FFF, 0}, samples)
+
+	require.EqualError(t, speaker.WriteWAV(&b, 0, cpu.ClockRate), "invalid sample rate: 0")
+}
+
+func TestSpeakerGolden(t *testing.T) {
+	sources, err := filepath.Glob("testdata/speaker/*.asm")
+	require.NoError(t, err)
+	require.NotEmpty(t, sources)
+
+	for _, source := range sources {
+		name := strings.TrimSuffix(filepath.Base(source), ".asm")
+
+		t.Run(name, func(t *testing.T) {
+			src, err := os.ReadFile(source)
+			require.NoError(t, err)
+
+			m := cpu.NewMachine()
+			speaker := cpu.NewPC(m).Speaker
+			_, err = runProgram(t, m, 0x1000, string(src))
+			require.NoError(t, err)
+
+			var b bytes.Buffer
+			require.NoError(t, speaker.WriteWAV(&b, 8000, m.Clocks))
+			got := b.Bytes()
+
+			goldenPath := strings.TrimSuffix(source, ".asm") + ".wav"
+			if *update {
+				require.NoError(t, os.WriteFile(goldenPath, got, 0o644))
+			}
+
+			want, err := os.ReadFile(goldenPath)
+			require.NoError(t, err)
+			require.Equal(t, want, got)
+		})
+	}
+}
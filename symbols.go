@@ -0,0 +1,94 @@
+package cpu
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// ParseSymbols reads a symbol file: one "<offset> <name>" pair per line.
+// Offsets could be decimal or prefixed with 0x, lines starting with ";" are comments
+func ParseSymbols(r io.Reader) (map[int]string, error) {
+	symbols := make(map[int]string)
+
+	scanner := bufio.NewScanner(r)
+	for lineNum := 1; scanner.Scan(); lineNum++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, ";") {
+			continue
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("line %d: expected \"<offset> <name>\", got %q", lineNum, line)
+		}
+
+		// NOTE: a leading zero doesn't make an offset octal
+		var (
+			offset int64
+			err    error
+		)
+		if hex, ok := strings.CutPrefix(strings.ToLower(fields[0]), "0x"); ok {
+			offset, err = strconv.ParseInt(hex, 16, 32)
+		} else {
+			offset, err = strconv.ParseInt(fields[0], 10, 32)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid offset: %q", lineNum, fields[0])
+		}
+
+		symbols[int(offset)] = fields[1]
+	}
+
+	return symbols, scanner.Err()
+}
+
+// assignLabels names all jump targets which are at instruction boundaries
+// (or at the end of the stream). User symbols are kept as is, generated
+// names skip the ones they take
+func assignLabels(insts []decodedInst, end int, symbols map[int]string) map[int]string {
+	boundaries := make(map[int]bool, len(insts)+1)
+	for _, d := range insts {
+		boundaries[d.offset] = true
+	}
+	boundaries[end] = true
+
+	labels := make(map[int]string)
+	for offset, name := range symbols {
+		if boundaries[offset] {
+			labels[offset] = name
+		}
+	}
+
+	var targets []int
+	for _, d := range insts {
+		if !d.rule.JMP {
+			continue
+		}
+		target := d.offset + d.size + int(d.inst.jump)
+		if _, ok := labels[target]; !ok && boundaries[target] && !slices.Contains(targets, target) {
+			targets = append(targets, target)
+		}
+	}
+
+	taken := make(map[string]bool, len(symbols))
+	for _, name := range symbols {
+		taken[name] = true
+	}
+
+	slices.Sort(targets)
+	n := 0
+	for _, target := range targets {
+		name := fmt.Sprintf("label_%d", n)
+		for ; taken[name]; name = fmt.Sprintf("label_%d", n) {
+			n++
+		}
+		labels[target] = name
+		n++
+	}
+
+	return labels
+}
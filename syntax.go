@@ -0,0 +1,281 @@
+package cpu
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Syntax is an assembly dialect of Disassemble output
+type Syntax uint8
+
+const (
+	// SyntaxNASM is the default: "mov word [bp + si + 4], 1"
+	SyntaxNASM Syntax = iota
+	// SyntaxMASM is MASM/TASM: "mov word ptr [bp+si+4], 1", hex numbers
+	// have the h suffix and a leading 0 before a letter: 0Ah
+	SyntaxMASM
+	// SyntaxATT is GNU as AT&T: "movw $1, 4(%bp,%si)"
+	SyntaxATT
+)
+
+// WithSyntax selects the assembly dialect
+func WithSyntax(syntax Syntax) Option {
+	return func(o *options) {
+		o.syntax = syntax
+	}
+}
+
+// dialect formats instructions for a specific assembler
+type dialect interface {
+	header() string
+	commentPrefix() string
+	// inst formats the instruction. label is the name of the jump target, empty if there is none
+	inst(inst Instruction, r Rule, label string) string
+	// db and dw format data, always in hex
+	db(raw []byte) string
+	dw(words []uint16) string
+}
+
+func newDialect(syntax Syntax, nf NumberFormat) dialect {
+	switch syntax {
+	case SyntaxMASM:
+		return masm{nf: nf}
+	case SyntaxATT:
+		return att{nf: nf}
+	default:
+		return nasm{nf: nf}
+	}
+}
+
+// jmpInstSize is the size of all the jumps which are supported. Assemblers
+// count offsets from the start of an instruction, but the CPU — from the end
+const jmpInstSize = 2
+
+type nasm struct {
+	nf NumberFormat
+}
+
+func (nasm) header() string        { return "bits 16" }
+func (nasm) commentPrefix() string { return ";" }
+
+func (n nasm) inst(inst Instruction, r Rule, label string) string {
+	if r.JMP {
+		switch {
+		case label != "":
+			return fmt.Sprintf("%s %s", inst.mnemonic, label)
+		case int(inst.jump)+jmpInstSize > 0:
+			return fmt.Sprintf("%s $+%d+0", inst.mnemonic, int(inst.jump)+jmpInstSize)
+		case int(inst.jump)+jmpInstSize == 0:
+			return fmt.Sprintf("%s $+0", inst.mnemonic)
+		default:
+			return fmt.Sprintf("%s $%d+0", inst.mnemonic, int(inst.jump)+jmpInstSize)
+		}
+	}
+
+	if inst.dst.kind == 0 {
+		return inst.mnemonic.String()
+	}
+
+	if inst.src.kind == 0 {
+		return fmt.Sprintf("%s %s", inst.mnemonic, inst.dst.format(n.nf))
+	}
+
+	dst, src := inst.dst.format(n.nf), inst.src.format(n.nf)
+
+	if inst.dst.kind == opKindEAC && inst.src.kind == opKindImm {
+		switch {
+		case inst.src.imm.word && inst.mnemonic == MOV:
+			return fmt.Sprintf("%s %s, word %s", inst.mnemonic, dst, src)
+		case inst.src.imm.word && inst.mnemonic != MOV:
+			return fmt.Sprintf("%s word %s, %s", inst.mnemonic, dst, src)
+		case !inst.src.imm.word && inst.mnemonic == MOV:
+			return fmt.Sprintf("%s byte %s, %s", inst.mnemonic, dst, src)
+		default:
+			return fmt.Sprintf("%s %s, byte %s", inst.mnemonic, dst, src)
+		}
+	}
+
+	return fmt.Sprintf("%s %s, %s", inst.mnemonic, dst, src)
+}
+
+func (nasm) db(raw []byte) string {
+	return "db " + joinBytes(raw, byteHex)
+}
+
+func (nasm) dw(words []uint16) string {
+	return "dw " + joinWords(words, wordHex)
+}
+
+type masm struct {
+	nf NumberFormat
+}
+
+func (masm) header() string        { return ".8086" }
+func (masm) commentPrefix() string { return ";" }
+
+func (m masm) inst(inst Instruction, r Rule, label string) string {
+	switch {
+	case r.JMP && label != "":
+		return fmt.Sprintf("%s %s", inst.mnemonic, label)
+	case r.JMP:
+		return fmt.Sprintf("%s $%s", inst.mnemonic, signed(int(inst.jump)+jmpInstSize, func(v int) string {
+			return m.nf.number(v, masmNumbers)
+		}))
+	case inst.dst.kind == 0:
+		return inst.mnemonic.String()
+	case inst.src.kind == 0:
+		return fmt.Sprintf("%s %s", inst.mnemonic, m.operand(inst.dst, inst.width()))
+	default:
+		width := inst.width()
+		return fmt.Sprintf("%s %s, %s", inst.mnemonic, m.operand(inst.dst, width), m.operand(inst.src, width))
+	}
+}
+
+func (m masm) operand(o Operand, width int) string {
+	switch o.kind {
+	case opKindReg:
+		return o.reg.String()
+	case opKindImm:
+		return m.nf.immediate(o.imm.val, o.imm.word, masmNumbers)
+	case opKindEAC:
+		ptr := "byte ptr "
+		if width == 16 {
+			ptr = "word ptr "
+		}
+
+		if o.eac.form == 0b000 {
+			return fmt.Sprintf("%sds:[%s]", ptr, m.nf.address(o.eac.dispOrDA, masmNumbers))
+		}
+
+		var b strings.Builder
+		b.WriteString(ptr + "[" + o.eac.reg1.String())
+		if o.eac.reg2 != registerInvalid {
+			b.WriteString("+" + o.eac.reg2.String())
+		}
+		if o.eac.dispOrDA != 0 {
+			b.WriteString(signed(int(o.eac.dispOrDA), func(v int) string { return m.nf.number(v, masmNumbers) }))
+		}
+		b.WriteString("]")
+		return b.String()
+	default:
+		panic(fmt.Sprintf("unsupported operand kind: %d", o.kind))
+	}
+}
+
+// masmHex formats a number like 0FFh. Hex numbers starting with a letter
+// need a leading zero, otherwise they are identifiers
+func masmHex(v int) string {
+	if v < 0 {
+		return "-" + masmHex(-v)
+	}
+	s := fmt.Sprintf("%Xh", v)
+	if s[0] >= 'A' && s[0] <= 'F' {
+		s = "0" + s
+	}
+	return s
+}
+
+func (masm) db(raw []byte) string {
+	return "db " + joinBytes(raw, func(b byte) string { return masmHex(int(b)) })
+}
+
+func (masm) dw(words []uint16) string {
+	return "dw " + joinWords(words, func(w uint16) string { return masmHex(int(w)) })
+}
+
+type att struct {
+	nf NumberFormat
+}
+
+func (att) header() string        { return ".code16" }
+func (att) commentPrefix() string { return "#" }
+
+func (a att) inst(inst Instruction, r Rule, label string) string {
+	switch {
+	case r.JMP && label != "":
+		return fmt.Sprintf("%s %s", inst.mnemonic, label)
+	case r.JMP:
+		return fmt.Sprintf("%s .%s", inst.mnemonic, signed(int(inst.jump)+jmpInstSize, decimal))
+	case inst.dst.kind == 0:
+		return inst.mnemonic.String()
+	case inst.src.kind == 0:
+		return fmt.Sprintf("%s %s", inst.mnemonic, a.operand(inst.dst))
+	default:
+		suffix := "b"
+		if inst.width() == 16 {
+			suffix = "w"
+		}
+		// NOTE: the source goes first
+		return fmt.Sprintf("%s%s %s, %s", inst.mnemonic, suffix, a.operand(inst.src), a.operand(inst.dst))
+	}
+}
+
+func (a att) operand(o Operand) string {
+	switch o.kind {
+	case opKindReg:
+		return "%" + o.reg.String()
+	case opKindImm:
+		return "$" + a.nf.immediate(o.imm.val, o.imm.word, attNumbers)
+	case opKindEAC:
+		if o.eac.form == 0b000 {
+			return a.nf.address(o.eac.dispOrDA, attNumbers)
+		}
+
+		var b strings.Builder
+		if o.eac.dispOrDA != 0 {
+			b.WriteString(a.nf.number(int(o.eac.dispOrDA), attNumbers))
+		}
+		b.WriteString("(%" + o.eac.reg1.String())
+		if o.eac.reg2 != registerInvalid {
+			b.WriteString(",%" + o.eac.reg2.String())
+		}
+		b.WriteString(")")
+		return b.String()
+	default:
+		panic(fmt.Sprintf("unsupported operand kind: %d", o.kind))
+	}
+}
+
+func (att) db(raw []byte) string {
+	return ".byte " + joinBytes(raw, byteHex)
+}
+
+func (att) dw(words []uint16) string {
+	return ".word " + joinWords(words, wordHex)
+}
+
+// signed formats a number with an explicit sign: +4, -2, +0
+func signed(v int, format func(int) string) string {
+	if v < 0 {
+		return format(v)
+	}
+	return "+" + format(v)
+}
+
+func decimal(v int) string {
+	return fmt.Sprintf("%d", v)
+}
+
+func byteHex(b byte) string {
+	return fmt.Sprintf("0x%02x", b)
+}
+
+func joinBytes(raw []byte, format func(byte) string) string {
+	parts := make([]string, len(raw))
+	for i, b := range raw {
+		parts[i] = format(b)
+	}
+	return strings.Join(parts, ", ")
+}
+
+func wordHex(w uint16) string {
+	return fmt.Sprintf("0x%04x", w)
+}
+
+func joinWords(words []uint16, format func(uint16) string) string {
+	parts := make([]string, len(words))
+	for i, w := range words {
+		parts[i] = format(w)
+	}
+	return strings.Join(parts, ", ")
+}
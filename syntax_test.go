@@ -0,0 +1,113 @@
+package cpu_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	cpu "cpu8086"
+)
+
+func TestDisassembleSyntax(t *testing.T) {
+	// add word [bp + si + 1000], 29
+	// mov ax, [1000]
+	// mov cx, bx
+	// mov ah, [bx + si + 4]
+	// mov byte [bp + di], 7
+	// jne $-14+0
+	// int 33
+	// ret
+	// out dx, al
+	stream := []byte{
+		0x83, 0x82, 0xe8, 0x03, 0x1d,
+		0xa1, 0xe8, 0x03,
+		0x89, 0xd9,
+		0x8a, 0x60, 0x04,
+		0xc6, 0x03, 0x07,
+		0x75, 0xf0,
+		0xcd, 0x21,
+		0xc3,
+		0xee,
+	}
+
+	tests := []struct {
+		name    string
+		syntax  cpu.Syntax
+		numbers cpu.NumberFormat
+		want    string
+	}{
+		{
+			name:   "nasm",
+			syntax: cpu.SyntaxNASM,
+			want: "bits 16\n" +
+				"\nadd word [bp + si + 1000], 29" +
+				"\nmov ax, [1000]" +
+				"\nmov cx, bx" +
+				"\nmov ah, [bx + si + 4]" +
+				"\nmov byte [bp + di], 7" +
+				"\njne $-14+0" +
+				"\nint 33" +
+				"\nret" +
+				"\nout dx, al",
+		},
+		{
+			name:   "masm",
+			syntax: cpu.SyntaxMASM,
+			want: ".8086\n" +
+				"\nadd word ptr [bp+si+1000], 29" +
+				"\nmov ax, word ptr ds:[1000]" +
+				"\nmov cx, bx" +
+				"\nmov ah, byte ptr [bx+si+4]" +
+				"\nmov byte ptr [bp+di], 7" +
+				"\njne $-14" +
+				"\nint 33" +
+				"\nret" +
+				"\nout dx, al",
+		},
+		{
+			name:    "masm hex",
+			syntax:  cpu.SyntaxMASM,
+			numbers: cpu.NumberFormat{Hex: true},
+			want: ".8086\n" +
+				"\nadd word ptr [bp+si+3E8h], 1Dh" +
+				"\nmov ax, word ptr ds:[3E8h]" +
+				"\nmov cx, bx" +
+				"\nmov ah, byte ptr [bx+si+4h]" +
+				"\nmov byte ptr [bp+di], 7h" +
+				"\njne $-0Eh" +
+				"\nint 21h" +
+				"\nret" +
+				"\nout dx, al",
+		},
+		{
+			name:   "att",
+			syntax: cpu.SyntaxATT,
+			want: ".code16\n" +
+				"\naddw $29, 1000(%bp,%si)" +
+				"\nmovw 1000, %ax" +
+				"\nmovw %bx, %cx" +
+				"\nmovb 4(%bx,%si), %ah" +
+				"\nmovb $7, (%bp,%di)" +
+				"\njne .-14" +
+				"\nint $33" +
+				"\nret" +
+				"\noutb %al, %dx",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := cpu.Disassemble(stream, cpu.WithSyntax(tt.syntax), cpu.WithNumbers(tt.numbers))
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestDisassembleSyntaxComments(t *testing.T) {
+	stream := []byte{0x89, 0xd9}
+
+	got, err := cpu.Disassemble(stream, cpu.WithSyntax(cpu.SyntaxATT), cpu.WithComments(map[int]string{0: "copy"}))
+	require.NoError(t, err)
+	require.Equal(t, ".code16\n\nmovw %bx, %cx # copy", got)
+}
@@ -3,6 +3,7 @@ package cpu
 var Rules = []DecodingRule{
 	DecodingRule{
 		Mnemonic: 0x1,
+		Form:     0x0,
 		Bytes: [6]ByteDecoding{
 			ByteDecoding{
 				NotEmpty: true,
@@ -148,4 +149,6664 @@ var Rules = []DecodingRule{
 					},
 				},
 				Cond: 0x0}}},
+	DecodingRule{
+		Mnemonic: 0x1,
+		Form:     0x0,
+		Bytes: [6]ByteDecoding{
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0xa,
+						Mask:     127,
+						Shift:    1,
+						Literal:  99},
+					Part{
+						NotEmpty: true,
+						Kind:     0x6,
+						Mask:     1,
+						Shift:    0,
+						Literal:  -1},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0x2,
+						Mask:     3,
+						Shift:    6,
+						Literal:  -1},
+					Part{
+						NotEmpty: true,
+						Kind:     0xa,
+						Mask:     7,
+						Shift:    3,
+						Literal:  0},
+					Part{
+						NotEmpty: true,
+						Kind:     0x3,
+						Mask:     7,
+						Shift:    0,
+						Literal:  -1,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0x7,
+						Mask:     255,
+						Shift:    0,
+						Literal:  -1},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0x8,
+						Mask:     255,
+						Shift:    0,
+						Literal:  -1},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0x9,
+						Mask:     255,
+						Shift:    0,
+						Literal:  -1},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0x9,
+						Mask:     255,
+						Shift:    0,
+						Literal:  -1},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x1}}},
+	DecodingRule{
+		Mnemonic: 0x1,
+		Form:     0x0,
+		Bytes: [6]ByteDecoding{
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0xa,
+						Mask:     15,
+						Shift:    4,
+						Literal:  11},
+					Part{
+						NotEmpty: true,
+						Kind:     0x6,
+						Mask:     1,
+						Shift:    3,
+						Literal:  -1},
+					Part{
+						NotEmpty: true,
+						Kind:     0x4,
+						Mask:     7,
+						Shift:    0,
+						Literal:  -1,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0x9,
+						Mask:     255,
+						Shift:    0,
+						Literal:  -1},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0x9,
+						Mask:     255,
+						Shift:    0,
+						Literal:  -1},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x1},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0}}},
+	DecodingRule{
+		Mnemonic: 0x1,
+		Form:     0x1,
+		Bytes: [6]ByteDecoding{
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0xa,
+						Mask:     127,
+						Shift:    1,
+						Literal:  80},
+					Part{
+						NotEmpty: true,
+						Kind:     0x6,
+						Mask:     1,
+						Shift:    0,
+						Literal:  -1},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0xc,
+						Mask:     255,
+						Shift:    0,
+						Literal:  -1},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0xd,
+						Mask:     255,
+						Shift:    0,
+						Literal:  -1},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0}}},
+	DecodingRule{
+		Mnemonic: 0x1,
+		Form:     0x2,
+		Bytes: [6]ByteDecoding{
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0xa,
+						Mask:     127,
+						Shift:    1,
+						Literal:  81},
+					Part{
+						NotEmpty: true,
+						Kind:     0x6,
+						Mask:     1,
+						Shift:    0,
+						Literal:  -1},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0xc,
+						Mask:     255,
+						Shift:    0,
+						Literal:  -1},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0xd,
+						Mask:     255,
+						Shift:    0,
+						Literal:  -1},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0}}},
+	DecodingRule{
+		Mnemonic: 0x2,
+		Form:     0x0,
+		Bytes: [6]ByteDecoding{
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0xa,
+						Mask:     63,
+						Shift:    2,
+						Literal:  0},
+					Part{
+						NotEmpty: true,
+						Kind:     0x5,
+						Mask:     1,
+						Shift:    1,
+						Literal:  -1},
+					Part{
+						NotEmpty: true,
+						Kind:     0x6,
+						Mask:     1,
+						Shift:    0,
+						Literal:  -1,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0x2,
+						Mask:     3,
+						Shift:    6,
+						Literal:  -1},
+					Part{
+						NotEmpty: true,
+						Kind:     0x4,
+						Mask:     7,
+						Shift:    3,
+						Literal:  -1},
+					Part{
+						NotEmpty: true,
+						Kind:     0x3,
+						Mask:     7,
+						Shift:    0,
+						Literal:  -1,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0x7,
+						Mask:     255,
+						Shift:    0,
+						Literal:  -1},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0x8,
+						Mask:     255,
+						Shift:    0,
+						Literal:  -1},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0}}},
+	DecodingRule{
+		Mnemonic: 0x2,
+		Form:     0x0,
+		Bytes: [6]ByteDecoding{
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0xa,
+						Mask:     63,
+						Shift:    2,
+						Literal:  32},
+					Part{
+						NotEmpty: true,
+						Kind:     0xb,
+						Mask:     1,
+						Shift:    1,
+						Literal:  -1},
+					Part{
+						NotEmpty: true,
+						Kind:     0x6,
+						Mask:     1,
+						Shift:    0,
+						Literal:  -1,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0x2,
+						Mask:     3,
+						Shift:    6,
+						Literal:  -1},
+					Part{
+						NotEmpty: true,
+						Kind:     0xa,
+						Mask:     7,
+						Shift:    3,
+						Literal:  0},
+					Part{
+						NotEmpty: true,
+						Kind:     0x3,
+						Mask:     7,
+						Shift:    0,
+						Literal:  -1,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0x7,
+						Mask:     255,
+						Shift:    0,
+						Literal:  -1},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0x8,
+						Mask:     255,
+						Shift:    0,
+						Literal:  -1},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0x9,
+						Mask:     255,
+						Shift:    0,
+						Literal:  -1},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0x9,
+						Mask:     255,
+						Shift:    0,
+						Literal:  -1},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x2}}},
+	DecodingRule{
+		Mnemonic: 0x2,
+		Form:     0x0,
+		Bytes: [6]ByteDecoding{
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0xa,
+						Mask:     127,
+						Shift:    1,
+						Literal:  2},
+					Part{
+						NotEmpty: true,
+						Kind:     0x6,
+						Mask:     1,
+						Shift:    0,
+						Literal:  -1},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0x9,
+						Mask:     255,
+						Shift:    0,
+						Literal:  -1},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0x9,
+						Mask:     255,
+						Shift:    0,
+						Literal:  -1},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x1},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0}}},
+	DecodingRule{
+		Mnemonic: 0x3,
+		Form:     0x0,
+		Bytes: [6]ByteDecoding{
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0xa,
+						Mask:     63,
+						Shift:    2,
+						Literal:  10},
+					Part{
+						NotEmpty: true,
+						Kind:     0x5,
+						Mask:     1,
+						Shift:    1,
+						Literal:  -1},
+					Part{
+						NotEmpty: true,
+						Kind:     0x6,
+						Mask:     1,
+						Shift:    0,
+						Literal:  -1,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0x2,
+						Mask:     3,
+						Shift:    6,
+						Literal:  -1},
+					Part{
+						NotEmpty: true,
+						Kind:     0x4,
+						Mask:     7,
+						Shift:    3,
+						Literal:  -1},
+					Part{
+						NotEmpty: true,
+						Kind:     0x3,
+						Mask:     7,
+						Shift:    0,
+						Literal:  -1,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0x7,
+						Mask:     255,
+						Shift:    0,
+						Literal:  -1},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0x8,
+						Mask:     255,
+						Shift:    0,
+						Literal:  -1},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0}}},
+	DecodingRule{
+		Mnemonic: 0x3,
+		Form:     0x0,
+		Bytes: [6]ByteDecoding{
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0xa,
+						Mask:     63,
+						Shift:    2,
+						Literal:  32},
+					Part{
+						NotEmpty: true,
+						Kind:     0xb,
+						Mask:     1,
+						Shift:    1,
+						Literal:  -1},
+					Part{
+						NotEmpty: true,
+						Kind:     0x6,
+						Mask:     1,
+						Shift:    0,
+						Literal:  -1,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0x2,
+						Mask:     3,
+						Shift:    6,
+						Literal:  -1},
+					Part{
+						NotEmpty: true,
+						Kind:     0xa,
+						Mask:     7,
+						Shift:    3,
+						Literal:  5},
+					Part{
+						NotEmpty: true,
+						Kind:     0x3,
+						Mask:     7,
+						Shift:    0,
+						Literal:  -1,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0x7,
+						Mask:     255,
+						Shift:    0,
+						Literal:  -1},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0x8,
+						Mask:     255,
+						Shift:    0,
+						Literal:  -1},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0x9,
+						Mask:     255,
+						Shift:    0,
+						Literal:  -1},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0x9,
+						Mask:     255,
+						Shift:    0,
+						Literal:  -1},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x2}}},
+	DecodingRule{
+		Mnemonic: 0x3,
+		Form:     0x0,
+		Bytes: [6]ByteDecoding{
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0xa,
+						Mask:     127,
+						Shift:    1,
+						Literal:  22},
+					Part{
+						NotEmpty: true,
+						Kind:     0x6,
+						Mask:     1,
+						Shift:    0,
+						Literal:  -1},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0x9,
+						Mask:     255,
+						Shift:    0,
+						Literal:  -1},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0x9,
+						Mask:     255,
+						Shift:    0,
+						Literal:  -1},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x1},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0}}},
+	DecodingRule{
+		Mnemonic: 0x4,
+		Form:     0x0,
+		Bytes: [6]ByteDecoding{
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0xa,
+						Mask:     63,
+						Shift:    2,
+						Literal:  14},
+					Part{
+						NotEmpty: true,
+						Kind:     0x5,
+						Mask:     1,
+						Shift:    1,
+						Literal:  -1},
+					Part{
+						NotEmpty: true,
+						Kind:     0x6,
+						Mask:     1,
+						Shift:    0,
+						Literal:  -1,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0x2,
+						Mask:     3,
+						Shift:    6,
+						Literal:  -1},
+					Part{
+						NotEmpty: true,
+						Kind:     0x4,
+						Mask:     7,
+						Shift:    3,
+						Literal:  -1},
+					Part{
+						NotEmpty: true,
+						Kind:     0x3,
+						Mask:     7,
+						Shift:    0,
+						Literal:  -1,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0x7,
+						Mask:     255,
+						Shift:    0,
+						Literal:  -1},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0x8,
+						Mask:     255,
+						Shift:    0,
+						Literal:  -1},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0}}},
+	DecodingRule{
+		Mnemonic: 0x4,
+		Form:     0x0,
+		Bytes: [6]ByteDecoding{
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0xa,
+						Mask:     63,
+						Shift:    2,
+						Literal:  32},
+					Part{
+						NotEmpty: true,
+						Kind:     0xb,
+						Mask:     1,
+						Shift:    1,
+						Literal:  -1},
+					Part{
+						NotEmpty: true,
+						Kind:     0x6,
+						Mask:     1,
+						Shift:    0,
+						Literal:  -1,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0x2,
+						Mask:     3,
+						Shift:    6,
+						Literal:  -1},
+					Part{
+						NotEmpty: true,
+						Kind:     0xa,
+						Mask:     7,
+						Shift:    3,
+						Literal:  7},
+					Part{
+						NotEmpty: true,
+						Kind:     0x3,
+						Mask:     7,
+						Shift:    0,
+						Literal:  -1,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0x7,
+						Mask:     255,
+						Shift:    0,
+						Literal:  -1},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0x8,
+						Mask:     255,
+						Shift:    0,
+						Literal:  -1},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0x9,
+						Mask:     255,
+						Shift:    0,
+						Literal:  -1},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0x9,
+						Mask:     255,
+						Shift:    0,
+						Literal:  -1},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x2}}},
+	DecodingRule{
+		Mnemonic: 0x4,
+		Form:     0x0,
+		Bytes: [6]ByteDecoding{
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0xa,
+						Mask:     127,
+						Shift:    1,
+						Literal:  30},
+					Part{
+						NotEmpty: true,
+						Kind:     0x6,
+						Mask:     1,
+						Shift:    0,
+						Literal:  -1},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0x9,
+						Mask:     255,
+						Shift:    0,
+						Literal:  -1},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0x9,
+						Mask:     255,
+						Shift:    0,
+						Literal:  -1},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x1},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0}}},
+	DecodingRule{
+		Mnemonic: 0x6,
+		Form:     0x0,
+		Bytes: [6]ByteDecoding{
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0xa,
+						Mask:     255,
+						Shift:    0,
+						Literal:  116},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0xe,
+						Mask:     255,
+						Shift:    0,
+						Literal:  -1},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0}}},
+	DecodingRule{
+		Mnemonic: 0x7,
+		Form:     0x0,
+		Bytes: [6]ByteDecoding{
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0xa,
+						Mask:     255,
+						Shift:    0,
+						Literal:  124},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0xe,
+						Mask:     255,
+						Shift:    0,
+						Literal:  -1},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0}}},
+	DecodingRule{
+		Mnemonic: 0x8,
+		Form:     0x0,
+		Bytes: [6]ByteDecoding{
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0xa,
+						Mask:     255,
+						Shift:    0,
+						Literal:  126},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0xe,
+						Mask:     255,
+						Shift:    0,
+						Literal:  -1},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0}}},
+	DecodingRule{
+		Mnemonic: 0x9,
+		Form:     0x0,
+		Bytes: [6]ByteDecoding{
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0xa,
+						Mask:     255,
+						Shift:    0,
+						Literal:  114},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0xe,
+						Mask:     255,
+						Shift:    0,
+						Literal:  -1},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0}}},
+	DecodingRule{
+		Mnemonic: 0xa,
+		Form:     0x0,
+		Bytes: [6]ByteDecoding{
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0xa,
+						Mask:     255,
+						Shift:    0,
+						Literal:  118},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0xe,
+						Mask:     255,
+						Shift:    0,
+						Literal:  -1},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0}}},
+	DecodingRule{
+		Mnemonic: 0xb,
+		Form:     0x0,
+		Bytes: [6]ByteDecoding{
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0xa,
+						Mask:     255,
+						Shift:    0,
+						Literal:  122},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0xe,
+						Mask:     255,
+						Shift:    0,
+						Literal:  -1},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0}}},
+	DecodingRule{
+		Mnemonic: 0xc,
+		Form:     0x0,
+		Bytes: [6]ByteDecoding{
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0xa,
+						Mask:     255,
+						Shift:    0,
+						Literal:  112},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0xe,
+						Mask:     255,
+						Shift:    0,
+						Literal:  -1},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0}}},
+	DecodingRule{
+		Mnemonic: 0xd,
+		Form:     0x0,
+		Bytes: [6]ByteDecoding{
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0xa,
+						Mask:     255,
+						Shift:    0,
+						Literal:  120},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0xe,
+						Mask:     255,
+						Shift:    0,
+						Literal:  -1},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0}}},
+	DecodingRule{
+		Mnemonic: 0xe,
+		Form:     0x0,
+		Bytes: [6]ByteDecoding{
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0xa,
+						Mask:     255,
+						Shift:    0,
+						Literal:  117},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0xe,
+						Mask:     255,
+						Shift:    0,
+						Literal:  -1},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0}}},
+	DecodingRule{
+		Mnemonic: 0x5,
+		Form:     0x0,
+		Bytes: [6]ByteDecoding{
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0xa,
+						Mask:     255,
+						Shift:    0,
+						Literal:  117},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0xe,
+						Mask:     255,
+						Shift:    0,
+						Literal:  -1},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0}}},
+	DecodingRule{
+		Mnemonic: 0xf,
+		Form:     0x0,
+		Bytes: [6]ByteDecoding{
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0xa,
+						Mask:     255,
+						Shift:    0,
+						Literal:  125},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0xe,
+						Mask:     255,
+						Shift:    0,
+						Literal:  -1},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0}}},
+	DecodingRule{
+		Mnemonic: 0x10,
+		Form:     0x0,
+		Bytes: [6]ByteDecoding{
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0xa,
+						Mask:     255,
+						Shift:    0,
+						Literal:  127},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0xe,
+						Mask:     255,
+						Shift:    0,
+						Literal:  -1},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0}}},
+	DecodingRule{
+		Mnemonic: 0x11,
+		Form:     0x0,
+		Bytes: [6]ByteDecoding{
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0xa,
+						Mask:     255,
+						Shift:    0,
+						Literal:  115},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0xe,
+						Mask:     255,
+						Shift:    0,
+						Literal:  -1},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0}}},
+	DecodingRule{
+		Mnemonic: 0x12,
+		Form:     0x0,
+		Bytes: [6]ByteDecoding{
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0xa,
+						Mask:     255,
+						Shift:    0,
+						Literal:  119},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0xe,
+						Mask:     255,
+						Shift:    0,
+						Literal:  -1},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0}}},
+	DecodingRule{
+		Mnemonic: 0x13,
+		Form:     0x0,
+		Bytes: [6]ByteDecoding{
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0xa,
+						Mask:     255,
+						Shift:    0,
+						Literal:  123},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0xe,
+						Mask:     255,
+						Shift:    0,
+						Literal:  -1},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0}}},
+	DecodingRule{
+		Mnemonic: 0x14,
+		Form:     0x0,
+		Bytes: [6]ByteDecoding{
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0xa,
+						Mask:     255,
+						Shift:    0,
+						Literal:  113},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0xe,
+						Mask:     255,
+						Shift:    0,
+						Literal:  -1},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0}}},
+	DecodingRule{
+		Mnemonic: 0x15,
+		Form:     0x0,
+		Bytes: [6]ByteDecoding{
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0xa,
+						Mask:     255,
+						Shift:    0,
+						Literal:  121},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0xe,
+						Mask:     255,
+						Shift:    0,
+						Literal:  -1},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0}}},
+	DecodingRule{
+		Mnemonic: 0x16,
+		Form:     0x0,
+		Bytes: [6]ByteDecoding{
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0xa,
+						Mask:     255,
+						Shift:    0,
+						Literal:  226},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0xe,
+						Mask:     255,
+						Shift:    0,
+						Literal:  -1},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0}}},
+	DecodingRule{
+		Mnemonic: 0x17,
+		Form:     0x0,
+		Bytes: [6]ByteDecoding{
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0xa,
+						Mask:     255,
+						Shift:    0,
+						Literal:  225},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0xe,
+						Mask:     255,
+						Shift:    0,
+						Literal:  -1},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0}}},
+	DecodingRule{
+		Mnemonic: 0x18,
+		Form:     0x0,
+		Bytes: [6]ByteDecoding{
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0xa,
+						Mask:     255,
+						Shift:    0,
+						Literal:  224},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0xe,
+						Mask:     255,
+						Shift:    0,
+						Literal:  -1},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0}}},
+	DecodingRule{
+		Mnemonic: 0x19,
+		Form:     0x0,
+		Bytes: [6]ByteDecoding{
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0xa,
+						Mask:     255,
+						Shift:    0,
+						Literal:  227},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0xe,
+						Mask:     255,
+						Shift:    0,
+						Literal:  -1},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0}}},
+	DecodingRule{
+		Mnemonic: 0x1a,
+		Form:     0x0,
+		Bytes: [6]ByteDecoding{
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0xa,
+						Mask:     255,
+						Shift:    0,
+						Literal:  244},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0}}},
+	DecodingRule{
+		Mnemonic: 0x1b,
+		Form:     0x0,
+		Bytes: [6]ByteDecoding{
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0xa,
+						Mask:     255,
+						Shift:    0,
+						Literal:  235},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0xe,
+						Mask:     255,
+						Shift:    0,
+						Literal:  -1},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0}}},
+	DecodingRule{
+		Mnemonic: 0x1c,
+		Form:     0x0,
+		Bytes: [6]ByteDecoding{
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0xa,
+						Mask:     255,
+						Shift:    0,
+						Literal:  195},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0}}},
+	DecodingRule{
+		Mnemonic: 0x1d,
+		Form:     0x0,
+		Bytes: [6]ByteDecoding{
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0xa,
+						Mask:     255,
+						Shift:    0,
+						Literal:  205},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0x9,
+						Mask:     255,
+						Shift:    0,
+						Literal:  -1},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0}}},
+	DecodingRule{
+		Mnemonic: 0x1e,
+		Form:     0x3,
+		Bytes: [6]ByteDecoding{
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0xa,
+						Mask:     127,
+						Shift:    1,
+						Literal:  114},
+					Part{
+						NotEmpty: true,
+						Kind:     0x6,
+						Mask:     1,
+						Shift:    0,
+						Literal:  -1},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0x9,
+						Mask:     255,
+						Shift:    0,
+						Literal:  -1},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0}}},
+	DecodingRule{
+		Mnemonic: 0x1e,
+		Form:     0x3,
+		Bytes: [6]ByteDecoding{
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0xa,
+						Mask:     127,
+						Shift:    1,
+						Literal:  118},
+					Part{
+						NotEmpty: true,
+						Kind:     0x6,
+						Mask:     1,
+						Shift:    0,
+						Literal:  -1},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0}}},
+	DecodingRule{
+		Mnemonic: 0x1f,
+		Form:     0x4,
+		Bytes: [6]ByteDecoding{
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0xa,
+						Mask:     127,
+						Shift:    1,
+						Literal:  115},
+					Part{
+						NotEmpty: true,
+						Kind:     0x6,
+						Mask:     1,
+						Shift:    0,
+						Literal:  -1},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0x9,
+						Mask:     255,
+						Shift:    0,
+						Literal:  -1},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0}}},
+	DecodingRule{
+		Mnemonic: 0x1f,
+		Form:     0x4,
+		Bytes: [6]ByteDecoding{
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0xa,
+						Mask:     127,
+						Shift:    1,
+						Literal:  119},
+					Part{
+						NotEmpty: true,
+						Kind:     0x6,
+						Mask:     1,
+						Shift:    0,
+						Literal:  -1},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0}}},
+	DecodingRule{
+		Mnemonic: 0x20,
+		Form:     0x0,
+		Bytes: [6]ByteDecoding{
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0xa,
+						Mask:     255,
+						Shift:    0,
+						Literal:  250},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0}}},
+	DecodingRule{
+		Mnemonic: 0x21,
+		Form:     0x0,
+		Bytes: [6]ByteDecoding{
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0xa,
+						Mask:     255,
+						Shift:    0,
+						Literal:  251},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0}}},
+	DecodingRule{
+		Mnemonic: 0x22,
+		Form:     0x0,
+		Bytes: [6]ByteDecoding{
+			ByteDecoding{
+				NotEmpty: true,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: true,
+						Kind:     0xa,
+						Mask:     255,
+						Shift:    0,
+						Literal:  207},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0},
+			ByteDecoding{
+				NotEmpty: false,
+				Parts: [3]Part{
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0},
+					Part{
+						NotEmpty: false,
+						Kind:     0x0,
+						Mask:     0,
+						Shift:    0,
+						Literal:  0,
+					},
+				},
+				Cond: 0x0}}},
 }
@@ -0,0 +1,32 @@
+; clocks of every effective address form and memory transfer
+bits 16
+
+mov bx, 1000
+mov bp, 2000
+mov si, 3000
+mov di, 4000
+mov cx, bx
+mov dx, 12
+mov dx, [1000]
+mov cx, [bx]
+mov cx, [bp]
+mov [si], cx
+mov [di], cx
+mov cx, [bx + 1000]
+mov cx, [bp + 1000]
+add cx, [bx + si]
+add [bp + di], cx
+add word [bp + si + 1000], 10
+add dx, [bx + di + 1001]
+mov [1001], ax
+mov al, [1001]
+mov ax, [1001]
+mov ax, [bx + di]
+mov ax, [bp + si]
+mov ax, [bx + si + 2]
+mov ax, [bp + di + 2]
+cmp [bx], byte 1
+cmp word [bp + si], 1
+cmp cx, 3
+sub bx, 3
+hlt
@@ -0,0 +1,46 @@
+; draws a framed greeting with the BIOS teletype
+mov ah, 0eh
+mov al, 0xc9
+int 10h
+mov al, 0xcd
+mov cx, 7
+top:
+int 10h
+loop top
+mov al, 0xbb
+int 10h
+mov al, 13
+int 10h
+mov al, 10
+int 10h
+mov al, 0xba
+int 10h
+mov al, ' '
+int 10h
+mov al, 'H'
+int 10h
+mov al, 'e'
+int 10h
+mov al, 'l'
+int 10h
+mov al, 'l'
+int 10h
+mov al, 'o'
+int 10h
+mov al, ' '
+int 10h
+mov al, 0xba
+int 10h
+mov al, 13
+int 10h
+mov al, 10
+int 10h
+mov al, 0xc8
+int 10h
+mov al, 0xcd
+mov cx, 7
+bottom:
+int 10h
+loop bottom
+mov al, 0xbc
+int 10h
@@ -0,0 +1,30 @@
+; A 1 kHz beep of PIT channel 2 for about 10 ms, then clicks of bit 1 of
+; port 61h with the gate of the timer off
+org 0x1000
+mov al, 0xb6
+out 43h, al
+mov ax, 1193
+out 42h, al
+mov al, ah
+out 42h, al
+mov al, 3
+out 61h, al
+mov cx, 2800
+beep:
+loop beep
+mov bx, 8
+click:
+mov al, 2
+out 61h, al
+mov cx, 100
+high:
+loop high
+mov al, 0
+out 61h, al
+mov cx, 100
+low:
+loop low
+sub bx, 1
+jnz click
+cli
+hlt
@@ -0,0 +1,11 @@
+; flags of additions, subtractions and comparisons
+bits 16
+
+mov bx, -4093
+mov cx, 3841
+sub bx, cx
+mov sp, 998
+mov bp, 999
+cmp bp, sp
+add bp, 1027
+sub bp, 2026
@@ -0,0 +1,12 @@
+; a counted loop and a not taken jump
+bits 16
+
+mov cx, 3
+mov bx, 1000
+loop_start:
+add bx, 10
+sub cx, 1
+jnz loop_start
+cmp bx, 1030
+jne loop_start
+hlt
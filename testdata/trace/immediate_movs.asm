@@ -0,0 +1,11 @@
+; moves of immediates to every general register
+bits 16
+
+mov ax, 1
+mov bx, 2
+mov cx, 3
+mov dx, 4
+mov sp, 5
+mov bp, 6
+mov si, 7
+mov di, 8
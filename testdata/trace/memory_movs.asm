@@ -0,0 +1,16 @@
+; moves through memory addressed directly, by base and by base + index
+bits 16
+
+mov word [1000], 1
+mov word [1002], 2
+mov bx, 1000
+mov si, 2
+mov ax, [bx + si]
+add ax, [bx]
+mov bp, 1000
+mov [bp + si + 2], ax
+mov dx, [1004]
+mov cx, 4
+sum:
+add dl, [bx]
+loop sum
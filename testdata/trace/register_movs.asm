@@ -0,0 +1,17 @@
+; 8-bit and 16-bit moves between registers
+bits 16
+
+mov ax, 0x2222
+mov bx, 0x4444
+mov cx, 0x6666
+mov dx, 0x8888
+mov al, 0x11
+mov bh, 0x33
+mov cl, 0x55
+mov dh, 0x77
+mov ah, bl
+mov cl, dh
+mov sp, ax
+mov bp, bx
+mov si, cx
+mov di, dx
@@ -0,0 +1,10 @@
+; 16-bit immediates above 0x7fff are printed unsigned
+bits 16
+
+mov cx, 40000
+mov ax, 65535
+mov bx, 1000
+mov word [bx], 50000
+add word [bx], 32768
+mov dl, 200
+add ax, -1
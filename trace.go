@@ -0,0 +1,191 @@
+package cpu
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Trace executes instructions like Run, writing every one of them with the
+// registers, IP and flags it changed. The format is the one of the Computer
+// Enhance reference simulator, including the trailing space:
+//
+//	mov cx, bx ; cx:0x0->0x3 ip:0x2->0x4 flags:->Z
+//	mov word [bp+si+2], 10 ; ip:0x19->0x1e
+//	jne $-6 ; ip:0xc->0x6
+func (m *Machine) Trace(w io.Writer, maxSteps int, opts ...TraceOption) error {
+	var o traceOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	enc := json.NewEncoder(w)
+	return m.run(maxSteps, func(before *Machine, inst Instruction, r Rule) error {
+		if o.json {
+			return enc.Encode(m.jsonStep(before, inst, r, o))
+		}
+
+		var clocks string
+		if o.clocks {
+			clocks = m.lastClocks.annotation(m.Clocks) + " | "
+		}
+		if o.bus && m.Bus != nil {
+			clocks += m.Bus.annotation(m.lastBusClocks, m.lastClocks.Total()) + " | "
+		}
+		_, err := fmt.Fprintf(w, "%s ; %s%s\n", referenceText(inst, r), clocks, traceChanges(before, m))
+		return err
+	})
+}
+
+// TraceOption configures Trace
+type TraceOption func(*traceOptions)
+
+type traceOptions struct {
+	clocks bool
+	bus    bool
+	json   bool
+}
+
+// WithTraceClocks adds clocks of instructions (of the machine Model) and their
+// running total to the trace:
+//
+//	mov dx, word [+1000] ; Clocks: +14 = 36 (8 + 6ea) | dx:0xc->0x0 ip:0x11->0x15
+func WithTraceClocks() TraceOption {
+	return func(o *traceOptions) {
+		o.clocks = true
+	}
+}
+
+// referenceText formats the instruction as the reference simulator does:
+// memory operands always have their width, effective addresses have no
+// spaces and the sign of the displacement, jumps are relative to their start
+func referenceText(inst Instruction, r Rule) string {
+	if inst.mnemonic.IsJump() {
+		return fmt.Sprintf("%s $%+d", inst.mnemonic, int(inst.jump)+jmpInstSize)
+	}
+
+	var operands []string
+	for _, o := range [...]Operand{inst.dst, inst.src} {
+		switch o.kind {
+		case opKindReg:
+			operands = append(operands, o.reg.String())
+		case opKindImm:
+			operands = append(operands, referenceImmediate(o, r))
+		case opKindEAC:
+			width := "byte"
+			if inst.width() == 16 {
+				width = "word"
+			}
+
+			var b strings.Builder
+			b.WriteString(width + " [")
+			if o.eac.form != 0b000 {
+				b.WriteString(o.eac.reg1.String())
+			}
+			if o.eac.reg2 != registerInvalid {
+				b.WriteString("+" + o.eac.reg2.String())
+			}
+			if o.eac.dispOrDA != 0 {
+				fmt.Fprintf(&b, "%+d", o.eac.dispOrDA)
+			}
+			b.WriteString("]")
+			operands = append(operands, b.String())
+		}
+	}
+
+	if len(operands) == 0 {
+		return inst.mnemonic.String()
+	}
+	return inst.mnemonic.String() + " " + strings.Join(operands, ", ")
+}
+
+// referenceImmediate formats an immediate of the instruction decoded by the
+// rule as the reference simulator does: 16-bit data is unsigned, 8-bit data
+// is signed only if it's extended to a word
+func referenceImmediate(o Operand, r Rule) string {
+	switch {
+	case r.CheckData == 0b10:
+		return fmt.Sprint(uint16(o.imm.val))
+	case !o.imm.word:
+		return fmt.Sprint(uint8(o.imm.val))
+	default:
+		return fmt.Sprint(o.imm.val)
+	}
+}
+
+// traceChanges lists what differs between two states: registers in the dump
+// order, then IP and flags
+func traceChanges(before, after *Machine) string {
+	var b strings.Builder
+
+	for _, r := range dumpOrder {
+		if x, y := before.Reg(r), after.Reg(r); x != y {
+			fmt.Fprintf(&b, "%s:0x%x->0x%x ", r, x, y)
+		}
+	}
+	if before.IP != after.IP {
+		fmt.Fprintf(&b, "ip:0x%x->0x%x ", before.IP, after.IP)
+	}
+	if before.Flags != after.Flags {
+		fmt.Fprintf(&b, "flags:%s->%s ", before.Flags, after.Flags)
+	}
+
+	return b.String()
+}
+
+// WithTraceBus adds clocks of instructions according to the machine Bus next to
+// their table clocks:
+//
+//	mov cx, bx ; Bus: +8 = 20 (table +2, queue 0) | cx:0x0->0x3 ip:0x2->0x4
+func WithTraceBus() TraceOption {
+	return func(o *traceOptions) {
+		o.bus = true
+	}
+}
+
+// WithTraceJSON writes a JSONStep object per line instead of the text
+func WithTraceJSON() TraceOption {
+	return func(o *traceOptions) {
+		o.json = true
+	}
+}
+
+// JSONStep is the schema of an executed instruction in the JSON trace. Fields
+// are never renamed or removed, new ones could be added.
+type JSONStep struct {
+	Offset      int    `json:"offset"`      // IP of the instruction
+	Instruction string `json:"instruction"` // as the text trace prints it
+	// Registers are the changed registers: their values before and after
+	Registers map[string][2]uint16 `json:"registers"`
+	IP        [2]uint16            `json:"ip"`
+	Flags     [2]string            `json:"flags"`
+	// Clocks are set with WithTraceClocks, BusClocks with WithTraceBus
+	Clocks    *int `json:"clocks,omitempty"`
+	BusClocks *int `json:"bus_clocks,omitempty"`
+}
+
+func (m *Machine) jsonStep(before *Machine, inst Instruction, r Rule, o traceOptions) JSONStep {
+	step := JSONStep{
+		Offset:      int(before.IP),
+		Instruction: referenceText(inst, r),
+		Registers:   map[string][2]uint16{},
+		IP:          [2]uint16{before.IP, m.IP},
+		Flags:       [2]string{before.Flags.String(), m.Flags.String()},
+	}
+	for _, r := range dumpOrder {
+		if x, y := before.Reg(r), m.Reg(r); x != y {
+			step.Registers[r.String()] = [2]uint16{x, y}
+		}
+	}
+
+	if o.clocks {
+		clocks := m.lastClocks.Total()
+		step.Clocks = &clocks
+	}
+	if o.bus && m.Bus != nil {
+		clocks := m.lastBusClocks
+		step.BusClocks = &clocks
+	}
+	return step
+}
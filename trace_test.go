@@ -0,0 +1,84 @@
+package cpu_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	cpu "cpu8086"
+	"cpu8086/asm"
+)
+
+func TestTrace(t *testing.T) {
+	sources, err := filepath.Glob("testdata/trace/*.asm")
+	require.NoError(t, err)
+	require.NotEmpty(t, sources)
+
+	for _, source := range sources {
+		name := strings.TrimSuffix(filepath.Base(source), ".asm")
+
+		t.Run(name, func(t *testing.T) {
+			src, err := os.ReadFile(source)
+			require.NoError(t, err)
+
+			program, err := asm.Assemble(string(src))
+			require.NoError(t, err)
+
+			m := cpu.NewMachine()
+			m.Load(program, 0)
+
+			var b strings.Builder
+			require.NoError(t, m.Trace(&b, 1000))
+			b.WriteString("\nFinal registers:\n")
+			require.NoError(t, m.DumpRegisters(&b))
+			got := b.String()
+
+			goldenPath := strings.TrimSuffix(source, ".asm") + ".txt"
+			if *update {
+				require.NoError(t, os.WriteFile(goldenPath, []byte(got), 0o644))
+			}
+
+			want, err := os.ReadFile(goldenPath)
+			require.NoError(t, err)
+			require.Equal(t, string(want), got)
+		})
+	}
+}
+
+// TestTraceListings compares the trace of the listings with the outputs of the
+// reference simulator the course publishes next to them
+func TestTraceListings(t *testing.T) {
+	outputs, err := filepath.Glob("listings/*.txt")
+	require.NoError(t, err)
+	require.NotEmpty(t, outputs)
+
+	for _, output := range outputs {
+		name := strings.TrimSuffix(filepath.Base(output), ".txt")
+
+		t.Run(name, func(t *testing.T) {
+			src, err := os.ReadFile("listings/" + name + ".asm")
+			require.NoError(t, err)
+
+			program, err := asm.Assemble(string(src))
+			require.NoError(t, err)
+
+			m := cpu.NewMachine()
+			m.Load(program, 0)
+
+			var b strings.Builder
+			require.NoError(t, m.Trace(&b, 1000))
+			b.WriteString("\nFinal registers:\n")
+			require.NoError(t, m.DumpRegisters(&b))
+
+			data, err := os.ReadFile(output)
+			require.NoError(t, err)
+			// NOTE: the first line is the header of the reference simulator
+			header, want, _ := strings.Cut(string(data), "\n")
+			require.Equal(t, "--- test\\listing_"+name+" execution ---", header)
+			require.Equal(t, want, b.String())
+		})
+	}
+}